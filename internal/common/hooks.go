@@ -0,0 +1,48 @@
+package common
+
+import (
+	"strconv"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/release"
+)
+
+// hookAnnotation returns the comma separated values of the hook annotation of the manifest.
+func hookAnnotation(manifest K8sManifest, annotation string) []string {
+	metadata, _ := manifest["metadata"].(map[string]any)
+	annotations, _ := metadata["annotations"].(map[string]any)
+	value, _ := annotations[annotation].(string)
+
+	values := make([]string, 0)
+	for part := range strings.SplitSeq(value, ",") {
+		if trimmed := strings.TrimSpace(part); trimmed != "" {
+			values = append(values, trimmed)
+		}
+	}
+	return values
+}
+
+// IsHook checks if the manifest is a Helm hook, including Helm test hooks.
+func IsHook(manifest K8sManifest) bool {
+	return len(HookEvents(manifest)) > 0
+}
+
+// HookEvents returns the events of the Helm hook, like pre-install or test.
+func HookEvents(manifest K8sManifest) []string {
+	return hookAnnotation(manifest, release.HookAnnotation)
+}
+
+// HookWeight returns the weight of the Helm hook, which defaults to 0 like Helm does.
+func HookWeight(manifest K8sManifest) int {
+	values := hookAnnotation(manifest, release.HookWeightAnnotation)
+	if len(values) == 0 {
+		return 0
+	}
+	weight, _ := strconv.Atoi(values[0])
+	return weight
+}
+
+// HookDeletePolicies returns the delete policies of the Helm hook.
+func HookDeletePolicies(manifest K8sManifest) []string {
+	return hookAnnotation(manifest, release.HookDeleteAnnotation)
+}
@@ -0,0 +1,38 @@
+package common_test
+
+import (
+	"testing"
+
+	. "github.com/helm-unittest/helm-unittest/internal/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHookAnnotationsWhenHook(t *testing.T) {
+	manifest := TrustedUnmarshalYAML(`
+kind: Job
+metadata:
+  name: migrate
+  annotations:
+    helm.sh/hook: pre-install, pre-upgrade
+    helm.sh/hook-weight: "-5"
+    helm.sh/hook-delete-policy: before-hook-creation,hook-succeeded
+`)
+
+	assert.True(t, IsHook(manifest))
+	assert.Equal(t, []string{"pre-install", "pre-upgrade"}, HookEvents(manifest))
+	assert.Equal(t, -5, HookWeight(manifest))
+	assert.Equal(t, []string{"before-hook-creation", "hook-succeeded"}, HookDeletePolicies(manifest))
+}
+
+func TestHookAnnotationsWhenNoHook(t *testing.T) {
+	manifest := TrustedUnmarshalYAML(`
+kind: ConfigMap
+metadata:
+  name: config
+`)
+
+	assert.False(t, IsHook(manifest))
+	assert.Equal(t, []string{}, HookEvents(manifest))
+	assert.Equal(t, 0, HookWeight(manifest))
+	assert.Equal(t, []string{}, HookDeletePolicies(manifest))
+}
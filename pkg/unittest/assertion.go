@@ -3,6 +3,7 @@ package unittest
 import (
 	"fmt"
 	"reflect"
+	"slices"
 	"sort"
 	"strings"
 
@@ -18,13 +19,19 @@ import (
 // Assertion defines target and metrics to validate rendered result
 type Assertion struct {
 	Template             string
+	Stage                string
 	DocumentSelector     *valueutils.DocumentSelector
 	DocumentIndex        int
 	Not                  bool
+	Decode               map[string][]string
 	AssertType           string
+	Description          string
+	ExpectFailure        *ExpectFailure
 	validator            validators.Validatable
 	requireRenderSuccess bool
 	antonym              bool
+	releaseScope         bool
+	documentScope        bool
 	defaultTemplates     []string
 	config               AssertionConfig
 }
@@ -43,9 +50,20 @@ func (a *Assertion) configOrDefault() AssertionConfig {
 // Assert validate the rendered manifests with validator
 func (a *Assertion) Assert(
 	result *results.AssertionResult,
+) *results.AssertionResult {
+	return a.ExpectFailure.applyToAssertion(a.assert(result))
+}
+
+func (a *Assertion) assert(
+	result *results.AssertionResult,
 ) *results.AssertionResult {
 	result.AssertType = a.AssertType
 	result.Not = a.Not
+	result.Description = a.Description
+
+	if a.releaseScope {
+		return a.evaluateRelease(result)
+	}
 
 	var templates = a.computeTemplatesWithPostRender()
 
@@ -68,6 +86,10 @@ func (a *Assertion) Assert(
 		return a.evaluateEmptyTemplates(result)
 	}
 
+	if a.documentScope {
+		return a.evaluateDocuments(result, selectedTemplates, selectedDocsByTemplate)
+	}
+
 	return a.evaluateTemplates(result, selectedTemplates, selectedDocsByTemplate)
 }
 
@@ -107,7 +129,7 @@ func (a *Assertion) evaluateEmptyTemplates(
 		failInfo = append(failInfo, invalidRender)
 	} else {
 		var emptyTemplate []common.K8sManifest
-		_, validatePassed, failInfo = a.validateTemplate(emptyTemplate, emptyTemplate)
+		_, validatePassed, failInfo = a.validateTemplate("", emptyTemplate, emptyTemplate)
 	}
 
 	result.Passed = validatePassed
@@ -115,6 +137,52 @@ func (a *Assertion) evaluateEmptyTemplates(
 	return result
 }
 
+// evaluateRelease evaluates the assertion once against all rendered documents of the release,
+// ordered the same way Helm would install them.
+// It returns the assertion result with the validation status and failure information
+func (a *Assertion) evaluateRelease(
+	result *results.AssertionResult,
+) *results.AssertionResult {
+	templatesResult := a.configOrDefault().templatesResult
+
+	if a.requireRenderSuccess != a.configOrDefault().renderSucceed {
+		result.Passed = false
+		result.FailInfo = a.handleRenderError(flattenTemplatesResult(templatesResult))
+		return result
+	}
+
+	releaseDocs := installOrderedManifests(templatesResult)
+	_, result.Passed, result.FailInfo = a.validateTemplate("", releaseDocs, releaseDocs)
+	return result
+}
+
+// evaluateDocuments evaluates the assertion once against the selected documents of all selected templates,
+// so quantifiers apply across all documents instead of per template.
+// It returns the assertion result with the validation status and failure information
+func (a *Assertion) evaluateDocuments(
+	result *results.AssertionResult,
+	selectedTemplates []string,
+	selectedDocsByTemplate map[string][]common.K8sManifest,
+) *results.AssertionResult {
+	templatesResult := a.configOrDefault().templatesResult
+
+	if a.requireRenderSuccess != a.configOrDefault().renderSucceed {
+		result.Passed = false
+		result.FailInfo = a.handleRenderError(flattenTemplatesResult(templatesResult))
+		return result
+	}
+
+	rendered := make([]common.K8sManifest, 0)
+	selectedDocs := make([]common.K8sManifest, 0)
+	for _, template := range selectedTemplates {
+		rendered = append(rendered, templatesResult[template]...)
+		selectedDocs = append(selectedDocs, selectedDocsByTemplate[template]...)
+	}
+
+	_, result.Passed, result.FailInfo = a.validateTemplate("", rendered, selectedDocs)
+	return result
+}
+
 // evaluateTemplates evaluates the assertion for each selected template
 // It processes the templates and validates them using the configured validator
 // It returns the assertion result with the validation status and failure information
@@ -159,7 +227,7 @@ func (a *Assertion) processTemplate(template string, selectedDocs []common.K8sMa
 		return true, false, a.handleRenderError(rendered)
 	}
 
-	return a.validateTemplate(rendered, selectedDocs)
+	return a.validateTemplate(template, rendered, selectedDocs)
 }
 
 // handleRenderError handles the error when the rendered manifest is empty
@@ -174,22 +242,82 @@ func (a *Assertion) handleRenderError(rendered []common.K8sManifest) []string {
 // validateTemplate validates the rendered template using the configured validator
 // It returns a boolean indicating if the template needs to be added in the failure information,
 // a boolean indicating if the template is valid and a slice of failure information
-func (a *Assertion) validateTemplate(rendered []common.K8sManifest, selectedDocs []common.K8sManifest) (bool, bool, []string) {
+func (a *Assertion) validateTemplate(template string, rendered []common.K8sManifest, selectedDocs []common.K8sManifest) (bool, bool, []string) {
 	var validatePassed bool
 	var singleFailInfo []string
 
+	rendered, err := a.decodeManifests(rendered)
+	if err != nil {
+		return true, false, []string{"Error:", "\t" + err.Error()}
+	}
+	selectedDocs, err = a.decodeManifests(selectedDocs)
+	if err != nil {
+		return true, false, []string{"Error:", "\t" + err.Error()}
+	}
+
 	validatePassed, singleFailInfo = a.validator.Validate(&validators.ValidateContext{
+		Template:         template,
 		Docs:             rendered,
 		SelectedDocs:     &selectedDocs,
 		Negative:         a.Not != a.antonym,
 		SnapshotComparer: a.configOrDefault().snapshotComparer,
 		RenderError:      a.configOrDefault().renderError,
 		FailFast:         a.configOrDefault().failFast,
+		ClusterObjects:   a.configOrDefault().clusterObjects,
+		RenderContext:    a.configOrDefault().renderContext,
+		BaseDir:          a.configOrDefault().baseDir,
+		FileSystem:       a.configOrDefault().fileSystem,
+		JobContext:       a.configOrDefault().jobContext,
+		BaselineDocs:     a.baselineDocs(),
 	})
 
 	return true, validatePassed, singleFailInfo
 }
 
+// baselineDocs returns the documents of the `diffFrom` baseline in install order for release scoped assertions,
+// nil without a baseline.
+func (a *Assertion) baselineDocs() *[]common.K8sManifest {
+	baselineResult := a.configOrDefault().baselineResult
+	if !a.releaseScope || baselineResult == nil {
+		return nil
+	}
+	docs := installOrderedManifests(baselineResult)
+	return &docs
+}
+
+// decodeManifests decodes the values at the decode paths of the manifests, shorter paths first,
+// so values nested in a decoded value can be decoded as well.
+// It returns the decoded copies of the manifests.
+func (a *Assertion) decodeManifests(manifests []common.K8sManifest) ([]common.K8sManifest, error) {
+	if len(a.Decode) == 0 {
+		return manifests, nil
+	}
+
+	paths := make([]string, 0, len(a.Decode))
+	for path := range a.Decode {
+		paths = append(paths, path)
+	}
+	sort.Slice(paths, func(i, j int) bool {
+		if len(paths[i]) != len(paths[j]) {
+			return len(paths[i]) < len(paths[j])
+		}
+		return paths[i] < paths[j]
+	})
+
+	decodedManifests := make([]common.K8sManifest, 0, len(manifests))
+	for _, manifest := range manifests {
+		for _, path := range paths {
+			decoded, err := valueutils.DecodeValuesOfSetPath(manifest, path, a.Decode[path])
+			if err != nil {
+				return nil, err
+			}
+			manifest = decoded
+		}
+		decodedManifests = append(decodedManifests, manifest)
+	}
+	return decodedManifests, nil
+}
+
 func (a *Assertion) getDocumentsByDefaultTemplates(templatesResult map[string][]common.K8sManifest) map[string][]common.K8sManifest {
 	documentsByDefaultTemplates := map[string][]common.K8sManifest{}
 
@@ -258,10 +386,24 @@ func (a *Assertion) UnmarshalYAML(unmarshal func(any) error) error {
 	if err := a.parseDocumentSelector(assertDef); err != nil {
 		return err
 	}
+	if err := a.parseDecode(assertDef); err != nil {
+		return err
+	}
+	expectFailure, err := parseExpectFailure(assertDef)
+	if err != nil {
+		return err
+	}
+	a.ExpectFailure = expectFailure
 
 	if err := a.constructValidator(assertDef); err != nil {
 		return err
 	}
+	if err := a.constructQuantifierValidator(assertDef); err != nil {
+		return err
+	}
+	if err := a.constructCustomValidator(assertDef); err != nil {
+		return err
+	}
 
 	if a.validator == nil {
 		return a.validateAssertionType(assertDef)
@@ -285,6 +427,16 @@ func (a *Assertion) parseBasicFields(assertDef map[string]any) {
 	if template, ok := assertDef["template"].(string); ok {
 		a.Template = template
 	}
+
+	if stage, ok := assertDef["stage"].(string); ok {
+		a.Stage = stage
+	}
+
+	if description, ok := assertDef["description"].(string); ok {
+		a.Description = description
+	} else if message, ok := assertDef["message"].(string); ok {
+		a.Description = message
+	}
 }
 
 // parseDocumentSelector parses the documentSelector field if present.
@@ -299,10 +451,46 @@ func (a *Assertion) parseDocumentSelector(assertDef map[string]any) error {
 	return nil
 }
 
+// parseDecode parses the decode field if present, a path is decoded with a single format or a list of formats.
+func (a *Assertion) parseDecode(assertDef map[string]any) error {
+	decodeDef, ok := assertDef["decode"]
+	if !ok {
+		return nil
+	}
+
+	decodePaths, ok := decodeDef.(map[string]any)
+	if !ok {
+		return fmt.Errorf("decode should be a map of paths to formats")
+	}
+
+	a.Decode = make(map[string][]string, len(decodePaths))
+	for path, formatsDef := range decodePaths {
+		var formats []string
+		switch f := formatsDef.(type) {
+		case string:
+			formats = []string{f}
+		case []any:
+			for _, format := range f {
+				formats = append(formats, fmt.Sprint(format))
+			}
+		default:
+			return fmt.Errorf("decode formats of path '%s' should be a format or a list of formats", path)
+		}
+
+		for _, format := range formats {
+			if err := valueutils.ValidateDecodeFormat(format); err != nil {
+				return err
+			}
+		}
+		a.Decode[path] = formats
+	}
+	return nil
+}
+
 // validateAssertionType validates the assertion type and ensures at least one is defined.
 func (a *Assertion) validateAssertionType(assertDef map[string]any) error {
 	for key := range assertDef {
-		if key != "template" && key != "documentIndex" && key != "not" {
+		if !slices.Contains(reservedAssertionKeys, key) {
 			return fmt.Errorf("Assertion type `%s` is invalid", key)
 		}
 	}
@@ -320,6 +508,17 @@ func (a *Assertion) constructValidator(assertDef map[string]any) error {
 				)
 			}
 
+			if scalar, isScalar := params.(string); isScalar {
+				if param, ok := scalarAssertParams[assertName]; ok {
+					params = map[string]any{param: scalar}
+				}
+			}
+			if list, isList := params.([]any); isList {
+				if param, ok := listAssertParams[assertName]; ok {
+					params = map[string]any{param: list}
+				}
+			}
+
 			validator := reflect.New(correspondDef.validatorType).Interface()
 			if err := mapstructure.Decode(params, validator); err != nil {
 				return err
@@ -329,12 +528,89 @@ func (a *Assertion) constructValidator(assertDef map[string]any) error {
 			a.validator = validator.(validators.Validatable)
 			a.requireRenderSuccess = correspondDef.expectRenderSuccess
 			a.antonym = correspondDef.antonym
+			a.releaseScope = correspondDef.releaseScope
 			a.defaultTemplates = []string{a.Template}
 		}
 	}
 	return nil
 }
 
+// constructQuantifierValidator constructs the validator of the every, some or none quantifier,
+// validating the nested assertions against the elements of the array at the path, or else against the documents.
+func (a *Assertion) constructQuantifierValidator(assertDef map[string]any) error {
+	for _, quantifier := range quantifierAssertTypes {
+		params, ok := assertDef[quantifier]
+		if !ok {
+			continue
+		}
+
+		if a.validator != nil {
+			return fmt.Errorf(
+				"assertion type `%s` and `%s` is declared duplicately",
+				a.AssertType,
+				quantifier,
+			)
+		}
+
+		quantifierDef, ok := params.(map[string]any)
+		if !ok {
+			return fmt.Errorf("assertion type `%s` expects the asserts and an optional path", quantifier)
+		}
+
+		path, _ := quantifierDef["path"].(string)
+		asserts, err := parseQuantifiedAssertions(quantifier, quantifierDef["asserts"])
+		if err != nil {
+			return err
+		}
+
+		a.AssertType = quantifier
+		a.validator = validators.QuantifierValidator{
+			Quantifier: quantifier,
+			Path:       path,
+			Asserts:    asserts,
+		}
+		a.requireRenderSuccess = true
+		a.documentScope = path == ""
+		a.defaultTemplates = []string{a.Template}
+	}
+	return nil
+}
+
+// parseQuantifiedAssertions parses the nested assertions of a quantifier,
+// which are validated against a single element, so they can not select templates or documents.
+func parseQuantifiedAssertions(quantifier string, assertsDef any) ([]validators.QuantifiedAssertion, error) {
+	assertDefs, ok := assertsDef.([]any)
+	if !ok || len(assertDefs) == 0 {
+		return nil, fmt.Errorf("assertion type `%s` expects a list of asserts", quantifier)
+	}
+
+	asserts := make([]validators.QuantifiedAssertion, 0, len(assertDefs))
+	for _, assertDef := range assertDefs {
+		if nestedDef, ok := assertDef.(map[string]any); ok {
+			for _, option := range []string{"template", "documentIndex", "documentSelector", "decode", "stage"} {
+				if _, ok := nestedDef[option]; ok {
+					return nil, fmt.Errorf("nested asserts of `%s` do not support `%s`", quantifier, option)
+				}
+			}
+		}
+
+		nested := new(Assertion)
+		if err := common.YmlUnmarshal(common.TrustedMarshalYAML(assertDef), nested); err != nil {
+			return nil, err
+		}
+		if nested.releaseScope || !nested.requireRenderSuccess {
+			return nil, fmt.Errorf("assertion type `%s` is not supported in `%s`", nested.AssertType, quantifier)
+		}
+
+		asserts = append(asserts, validators.QuantifiedAssertion{
+			AssertType: nested.AssertType,
+			Validator:  nested.validator,
+			Negative:   nested.Not != nested.antonym,
+		})
+	}
+	return asserts, nil
+}
+
 func (a *Assertion) computeTemplatesWithPostRender() map[string][]common.K8sManifest {
 	// If we PostRendered, there's no guarantee the post-renderer will preserve our file mapping.  If it doesn't, the
 	// parser just puts the whole manifest in one "manifest.yaml" so handle that case:
@@ -360,43 +636,89 @@ type assertTypeDef struct {
 	validatorType       reflect.Type
 	antonym             bool
 	expectRenderSuccess bool
+	releaseScope        bool
+}
+
+// scalarAssertParams the assertion types accepting a scalar as parameters,
+// like `expression: object.spec.replicas > 1`, mapped to the parameter the scalar is assigned to.
+var scalarAssertParams = map[string]string{
+	"expression":         "expression",
+	"matchPolicy":        "policy",
+	"matchReleasePolicy": "policy",
+	"exec":               "cmd",
 }
 
+// listAssertParams the assertion types accepting a list as parameters,
+// like `unchangedExcept: [spec.replicas]`, mapped to the parameter the list is assigned to.
+var listAssertParams = map[string]string{
+	"addedDocuments":   "resources",
+	"removedDocuments": "resources",
+	"unchangedExcept":  "paths",
+}
+
+// quantifierAssertTypes the assertion types validating nested assertions against array elements or documents.
+var quantifierAssertTypes = []string{validators.QuantifierEvery, validators.QuantifierSome, validators.QuantifierNone}
+
 var assertTypeMapping = map[string]assertTypeDef{
-	"matchSnapshot":     {reflect.TypeOf(validators.MatchSnapshotValidator{}), false, true},
-	"matchSnapshotRaw":  {reflect.TypeOf(validators.MatchSnapshotRawValidator{}), false, true},
-	"equal":             {reflect.TypeOf(validators.EqualValidator{}), false, true},
-	"notEqual":          {reflect.TypeOf(validators.EqualValidator{}), true, true},
-	"greaterOrEqual":    {reflect.TypeOf(validators.EqualOrGreaterValidator{}), false, true},
-	"notGreaterOrEqual": {reflect.TypeOf(validators.EqualOrGreaterValidator{}), true, true},
-	"lessOrEqual":       {reflect.TypeOf(validators.EqualOrLessValidator{}), false, true},
-	"notLessOrEqual":    {reflect.TypeOf(validators.EqualOrLessValidator{}), true, true},
-	"equalRaw":          {reflect.TypeOf(validators.EqualRawValidator{}), false, true},
-	"notEqualRaw":       {reflect.TypeOf(validators.EqualRawValidator{}), true, true},
-	"exists":            {reflect.TypeOf(validators.ExistsValidator{}), false, true},
-	"notExists":         {reflect.TypeOf(validators.ExistsValidator{}), true, true},
-	"matchRegex":        {reflect.TypeOf(validators.MatchRegexValidator{}), false, true},
-	"notMatchRegex":     {reflect.TypeOf(validators.MatchRegexValidator{}), true, true},
-	"matchRegexRaw":     {reflect.TypeOf(validators.MatchRegexRawValidator{}), false, true},
-	"notMatchRegexRaw":  {reflect.TypeOf(validators.MatchRegexRawValidator{}), true, true},
-	"contains":          {reflect.TypeOf(validators.ContainsValidator{}), false, true},
-	"notContains":       {reflect.TypeOf(validators.ContainsValidator{}), true, true},
-	"isKind":            {reflect.TypeOf(validators.IsKindValidator{}), false, true},
-	"isAPIVersion":      {reflect.TypeOf(validators.IsAPIVersionValidator{}), false, true},
-	"hasDocuments":      {reflect.TypeOf(validators.HasDocumentsValidator{}), false, true},
-	"isSubset":          {reflect.TypeOf(validators.IsSubsetValidator{}), false, true},
-	"isNotSubset":       {reflect.TypeOf(validators.IsSubsetValidator{}), true, true},
-	"isNullOrEmpty":     {reflect.TypeOf(validators.IsNullOrEmptyValidator{}), false, true},
-	"isNotNullOrEmpty":  {reflect.TypeOf(validators.IsNullOrEmptyValidator{}), true, true},
-	"failedTemplate":    {reflect.TypeOf(validators.FailedTemplateValidator{}), false, false},
-	"notFailedTemplate": {reflect.TypeOf(validators.FailedTemplateValidator{}), true, true},
-	"containsDocument":  {reflect.TypeOf(validators.ContainsDocumentValidator{}), false, true},
-	"lengthEqual":       {reflect.TypeOf(validators.LengthEqualDocumentsValidator{}), false, true},
-	"notLengthEqual":    {reflect.TypeOf(validators.LengthEqualDocumentsValidator{}), true, true},
-	"isNull":            {reflect.TypeOf(validators.ExistsValidator{}), true, true},
-	"isNotNull":         {reflect.TypeOf(validators.ExistsValidator{}), false, true},
-	"isEmpty":           {reflect.TypeOf(validators.IsNullOrEmptyValidator{}), false, true},
-	"isNotEmpty":        {reflect.TypeOf(validators.IsNullOrEmptyValidator{}), true, true},
-	"isType":            {reflect.TypeOf(validators.IsTypeValidator{}), false, true},
-	"isNotType":         {reflect.TypeOf(validators.IsTypeValidator{}), true, true},
+	"matchSnapshot":     {reflect.TypeOf(validators.MatchSnapshotValidator{}), false, true, false},
+	"matchSnapshotRaw":  {reflect.TypeOf(validators.MatchSnapshotRawValidator{}), false, true, false},
+	"equal":             {reflect.TypeOf(validators.EqualValidator{}), false, true, false},
+	"notEqual":          {reflect.TypeOf(validators.EqualValidator{}), true, true, false},
+	"greaterOrEqual":    {reflect.TypeOf(validators.EqualOrGreaterValidator{}), false, true, false},
+	"notGreaterOrEqual": {reflect.TypeOf(validators.EqualOrGreaterValidator{}), true, true, false},
+	"lessOrEqual":       {reflect.TypeOf(validators.EqualOrLessValidator{}), false, true, false},
+	"notLessOrEqual":    {reflect.TypeOf(validators.EqualOrLessValidator{}), true, true, false},
+	"between":           {reflect.TypeOf(validators.BetweenValidator{}), false, true, false},
+	"inRange":           {reflect.TypeOf(validators.BetweenValidator{}), false, true, false},
+	"equalRaw":          {reflect.TypeOf(validators.EqualRawValidator{}), false, true, false},
+	"notEqualRaw":       {reflect.TypeOf(validators.EqualRawValidator{}), true, true, false},
+	"exists":            {reflect.TypeOf(validators.ExistsValidator{}), false, true, false},
+	"notExists":         {reflect.TypeOf(validators.ExistsValidator{}), true, true, false},
+	"matchRegex":        {reflect.TypeOf(validators.MatchRegexValidator{}), false, true, false},
+	"notMatchRegex":     {reflect.TypeOf(validators.MatchRegexValidator{}), true, true, false},
+	"matchRegexRaw":     {reflect.TypeOf(validators.MatchRegexRawValidator{}), false, true, false},
+	"notMatchRegexRaw":  {reflect.TypeOf(validators.MatchRegexRawValidator{}), true, true, false},
+	"contains":          {reflect.TypeOf(validators.ContainsValidator{}), false, true, false},
+	"notContains":       {reflect.TypeOf(validators.ContainsValidator{}), true, true, false},
+	"isKind":            {reflect.TypeOf(validators.IsKindValidator{}), false, true, false},
+	"isAPIVersion":      {reflect.TypeOf(validators.IsAPIVersionValidator{}), false, true, false},
+	"hasDocuments":      {reflect.TypeOf(validators.HasDocumentsValidator{}), false, true, false},
+	"isSubset":          {reflect.TypeOf(validators.IsSubsetValidator{}), false, true, false},
+	"isNotSubset":       {reflect.TypeOf(validators.IsSubsetValidator{}), true, true, false},
+	"isNullOrEmpty":     {reflect.TypeOf(validators.IsNullOrEmptyValidator{}), false, true, false},
+	"isNotNullOrEmpty":  {reflect.TypeOf(validators.IsNullOrEmptyValidator{}), true, true, false},
+	"failedTemplate":    {reflect.TypeOf(validators.FailedTemplateValidator{}), false, false, false},
+	"notFailedTemplate": {reflect.TypeOf(validators.FailedTemplateValidator{}), true, true, false},
+	"containsDocument":  {reflect.TypeOf(validators.ContainsDocumentValidator{}), false, true, false},
+	"lengthEqual":       {reflect.TypeOf(validators.LengthEqualDocumentsValidator{}), false, true, false},
+	"notLengthEqual":    {reflect.TypeOf(validators.LengthEqualDocumentsValidator{}), true, true, false},
+	"isNull":            {reflect.TypeOf(validators.ExistsValidator{}), true, true, false},
+	"isNotNull":         {reflect.TypeOf(validators.ExistsValidator{}), false, true, false},
+	"isEmpty":           {reflect.TypeOf(validators.IsNullOrEmptyValidator{}), false, true, false},
+	"isNotEmpty":        {reflect.TypeOf(validators.IsNullOrEmptyValidator{}), true, true, false},
+	"isType":            {reflect.TypeOf(validators.IsTypeValidator{}), false, true, false},
+	"isNotType":         {reflect.TypeOf(validators.IsTypeValidator{}), true, true, false},
+	"isHook":            {reflect.TypeOf(validators.IsHookValidator{}), false, true, false},
+	"containsLine":      {reflect.TypeOf(validators.ContainsLineValidator{}), false, true, false},
+	"notContainsLine":   {reflect.TypeOf(validators.ContainsLineValidator{}), true, true, false},
+	"expression":        {reflect.TypeOf(validators.ExpressionValidator{}), false, true, false},
+	"matchPolicy":       {reflect.TypeOf(validators.MatchPolicyValidator{}), false, true, false},
+	"matchJsonSchema":   {reflect.TypeOf(validators.MatchJsonSchemaValidator{}), false, true, false},
+	"matchSemver":       {reflect.TypeOf(validators.MatchSemverValidator{}), false, true, false},
+	"image":             {reflect.TypeOf(validators.ImageValidator{}), false, true, false},
+	// release scoped assertions, validating all documents of the release at once.
+	"installOrder":            {reflect.TypeOf(validators.InstallOrderValidator{}), false, true, true},
+	"notInstallOrder":         {reflect.TypeOf(validators.InstallOrderValidator{}), true, true, true},
+	"totalDocuments":          {reflect.TypeOf(validators.HasDocumentsValidator{}), false, true, true},
+	"uniqueResourceNames":     {reflect.TypeOf(validators.UniqueResourceNamesValidator{}), false, true, true},
+	"serviceSelectsPods":      {reflect.TypeOf(validators.ServiceSelectsPodsValidator{}), false, true, true},
+	"referencesResolved":      {reflect.TypeOf(validators.ReferencesResolvedValidator{}), false, true, true},
+	"ingressBackendsResolved": {reflect.TypeOf(validators.IngressBackendsResolvedValidator{}), false, true, true},
+	"matchReleasePolicy":      {reflect.TypeOf(validators.MatchReleasePolicyValidator{}), false, true, true},
+	"exec":                    {reflect.TypeOf(validators.ExecValidator{}), false, true, false},
+	// differential assertions, validating the release compared to the baseline of `diffFrom`.
+	"addedDocuments":   {reflect.TypeOf(validators.AddedDocumentsValidator{}), false, true, true},
+	"removedDocuments": {reflect.TypeOf(validators.RemovedDocumentsValidator{}), false, true, true},
+	"changedPaths":     {reflect.TypeOf(validators.ChangedPathsValidator{}), false, true, true},
+	"unchangedExcept":  {reflect.TypeOf(validators.UnchangedExceptValidator{}), false, true, true},
 }
@@ -0,0 +1,162 @@
+package unittest
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/helm-unittest/helm-unittest/pkg/unittest/validators"
+	"github.com/mitchellh/mapstructure"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// AssertionOptions the options of a custom assertion type registered with RegisterAssertion.
+type AssertionOptions struct {
+	// Antonym asserts contrarily to the validator, like notEqual is the antonym of equal.
+	Antonym bool
+	// ExpectRenderFailure validates the templates which failed to render, like failedTemplate.
+	// By default the templates should render successfully, like all other built-in assertion types.
+	ExpectRenderFailure bool
+	// ReleaseScope validates the documents of all templates of the release at once, in install order.
+	ReleaseScope bool
+	// ScalarParam the parameter a scalar is assigned to, like `expression: object.spec.replicas > 1`.
+	ScalarParam string
+	// Schema the JSON schema the parameters are validated against when the assertion is parsed.
+	Schema any
+}
+
+// customAssertTypeDef a registered custom assertion type.
+type customAssertTypeDef struct {
+	validatorFactory func() validators.Validatable
+	options          AssertionOptions
+	schema           *gojsonschema.Schema
+}
+
+var (
+	customAssertTypesLock sync.RWMutex
+	customAssertTypes     = map[string]customAssertTypeDef{}
+)
+
+// reservedAssertionKeys the keys of the assertion options, which can not be used as assertion type.
+var reservedAssertionKeys = []string{"template", "documentIndex", "documentSelector", "not", "decode", "description", "message", "expectFailure", "stage"}
+
+// RegisterAssertion registers a custom assertion type, so it can be used in test suites like the built-in
+// assertion types. The validatorFactory returns a pointer to a new validator, which the parameters
+// of the assertion are decoded into, the same way as the built-in validators.
+// Registering is meant to happen once, like in an init function, before the test suites are parsed.
+func RegisterAssertion(name string, validatorFactory func() validators.Validatable, options AssertionOptions) error {
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("assertion type name is empty")
+	}
+	if _, ok := assertTypeMapping[name]; ok || isQuantifierOrReservedKey(name) {
+		return fmt.Errorf("assertion type `%s` is a built-in assertion type or option", name)
+	}
+	if validatorFactory == nil {
+		return fmt.Errorf("assertion type `%s` has no validator factory", name)
+	}
+
+	validator := validatorFactory()
+	validatorValue := reflect.ValueOf(validator)
+	if validator == nil || validatorValue.Kind() != reflect.Pointer || validatorValue.IsNil() || validatorValue.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("assertion type `%s` validator factory should return a pointer to a struct, got %T", name, validator)
+	}
+
+	def := customAssertTypeDef{
+		validatorFactory: validatorFactory,
+		options:          options,
+	}
+	if options.Schema != nil {
+		schema, err := gojsonschema.NewSchema(gojsonschema.NewGoLoader(options.Schema))
+		if err != nil {
+			return fmt.Errorf("assertion type `%s` schema is invalid: %s", name, err.Error())
+		}
+		def.schema = schema
+	}
+
+	customAssertTypesLock.Lock()
+	defer customAssertTypesLock.Unlock()
+
+	if _, ok := customAssertTypes[name]; ok {
+		return fmt.Errorf("assertion type `%s` is already registered", name)
+	}
+	customAssertTypes[name] = def
+	return nil
+}
+
+// UnregisterAssertion removes a registered custom assertion type, built-in assertion types can not be removed.
+func UnregisterAssertion(name string) {
+	customAssertTypesLock.Lock()
+	defer customAssertTypesLock.Unlock()
+
+	delete(customAssertTypes, name)
+}
+
+// isQuantifierOrReservedKey returns whether the name is a quantifier or an assertion option.
+func isQuantifierOrReservedKey(name string) bool {
+	return slices.Contains(quantifierAssertTypes, name) || slices.Contains(reservedAssertionKeys, name)
+}
+
+// constructCustomValidator constructs the validator of a registered custom assertion type.
+func (a *Assertion) constructCustomValidator(assertDef map[string]any) error {
+	customAssertTypesLock.RLock()
+	defer customAssertTypesLock.RUnlock()
+
+	for assertName, def := range customAssertTypes {
+		params, ok := assertDef[assertName]
+		if !ok {
+			continue
+		}
+
+		if a.validator != nil {
+			return fmt.Errorf(
+				"assertion type `%s` and `%s` is declared duplicately",
+				a.AssertType,
+				assertName,
+			)
+		}
+
+		if scalar, isScalar := params.(string); isScalar && def.options.ScalarParam != "" {
+			params = map[string]any{def.options.ScalarParam: scalar}
+		}
+
+		if err := def.validateParams(assertName, params); err != nil {
+			return err
+		}
+
+		validator := def.validatorFactory()
+		if err := mapstructure.Decode(params, validator); err != nil {
+			return err
+		}
+
+		a.AssertType = assertName
+		a.validator = validator
+		a.requireRenderSuccess = !def.options.ExpectRenderFailure
+		a.antonym = def.options.Antonym
+		a.releaseScope = def.options.ReleaseScope
+		a.defaultTemplates = []string{a.Template}
+	}
+	return nil
+}
+
+// validateParams validates the parameters against the schema of the assertion type, when defined.
+func (def customAssertTypeDef) validateParams(assertName string, params any) error {
+	if def.schema == nil {
+		return nil
+	}
+
+	result, err := def.schema.Validate(gojsonschema.NewGoLoader(params))
+	if err != nil {
+		return err
+	}
+	if result.Valid() {
+		return nil
+	}
+
+	violations := make([]string, 0, len(result.Errors()))
+	for _, violation := range result.Errors() {
+		violations = append(violations, violation.String())
+	}
+	return fmt.Errorf("assertion type `%s` has invalid parameters: %s", assertName, strings.Join(violations, "; "))
+}
@@ -0,0 +1,146 @@
+package unittest_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+	. "github.com/helm-unittest/helm-unittest/pkg/unittest"
+	"github.com/helm-unittest/helm-unittest/pkg/unittest/results"
+	"github.com/helm-unittest/helm-unittest/pkg/unittest/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+// ownerLabelValidator a custom validator asserting the documents are owned by the Team.
+type ownerLabelValidator struct {
+	Team string
+}
+
+func (v ownerLabelValidator) Validate(context *validators.ValidateContext) (bool, []string) {
+	for _, manifest := range context.Manifests() {
+		metadata, _ := manifest["metadata"].(map[string]any)
+		labels, _ := metadata["labels"].(map[string]any)
+		if (labels["owner"] == v.Team) == context.Negative {
+			return false, []string{fmt.Sprintf("expected owner %s, got %v", v.Team, labels["owner"])}
+		}
+	}
+	return true, []string{}
+}
+
+func registerOwnerAssertions(t *testing.T) {
+	factory := func() validators.Validatable { return &ownerLabelValidator{} }
+	schema := map[string]any{
+		"type":                 "object",
+		"required":             []any{"team"},
+		"properties":           map[string]any{"team": map[string]any{"type": "string"}},
+		"additionalProperties": false,
+	}
+
+	assert.NoError(t, RegisterAssertion("ownedBy", factory, AssertionOptions{ScalarParam: "team", Schema: schema}))
+	assert.NoError(t, RegisterAssertion("notOwnedBy", factory, AssertionOptions{Antonym: true, ScalarParam: "team"}))
+	t.Cleanup(func() {
+		UnregisterAssertion("ownedBy")
+		UnregisterAssertion("notOwnedBy")
+	})
+}
+
+func TestRegisterAssertionWhenOk(t *testing.T) {
+	registerOwnerAssertions(t)
+
+	manifest := common.TrustedUnmarshalYAML(`
+kind: Deployment
+metadata:
+  labels:
+    owner: platform
+`)
+	renderedMap := map[string][]common.K8sManifest{
+		"t.yaml": {manifest},
+	}
+
+	assertionsYAML := `
+- template: t.yaml
+  ownedBy:
+    team: platform
+- template: t.yaml
+  notOwnedBy: web
+- template: t.yaml
+  every:
+    asserts:
+      - ownedBy: platform
+`
+	validateSucceededTestAssertions(t, assertionsYAML, 3, renderedMap, false)
+
+	negativeAssertion := new(Assertion)
+	common.YmlUnmarshalTestHelper("template: t.yaml\nownedBy: web\nnot: true\n", &negativeAssertion, t)
+	negativeAssertion.WithConfig(AssertionConfigBuilder{TemplatesResult: renderedMap, RenderSucceed: true}.Build())
+	result := negativeAssertion.Assert(&results.AssertionResult{})
+	assert.True(t, result.Passed, result.FailInfo)
+}
+
+func TestRegisterAssertionWithDefaultOptionsOnRenderedChart(t *testing.T) {
+	factory := func() validators.Validatable { return &ownerLabelValidator{} }
+	assert.NoError(t, RegisterAssertion("notOwnedByTeam", factory, AssertionOptions{}))
+	t.Cleanup(func() { UnregisterAssertion("notOwnedByTeam") })
+
+	testResult := runJobWithPostRenderer(t, `
+it: should validate the rendered chart like the built-in assertion types
+template: templates/deployment.yaml
+asserts:
+  - notOwnedByTeam:
+      team: platform
+    not: true
+`)
+
+	assert.NoError(t, testResult.ExecError)
+	assert.True(t, testResult.Passed, testResult.Stringify())
+}
+
+func TestRegisterAssertionWhenParamsInvalid(t *testing.T) {
+	registerOwnerAssertions(t)
+
+	assertion := new(Assertion)
+	err := common.YmlUnmarshal("ownedBy:\n  group: platform\n", &assertion)
+	assert.ErrorContains(t, err, "assertion type `ownedBy` has invalid parameters: ")
+	assert.ErrorContains(t, err, "team is required")
+}
+
+func TestRegisterAssertionWhenInvalid(t *testing.T) {
+	registerOwnerAssertions(t)
+	factory := func() validators.Validatable { return &ownerLabelValidator{} }
+
+	tests := []struct {
+		name, assertName, expectedError string
+		factory                         func() validators.Validatable
+		options                         AssertionOptions
+	}{
+		{name: "empty name", factory: factory, expectedError: "assertion type name is empty"},
+		{name: "built-in", assertName: "equal", factory: factory, expectedError: "assertion type `equal` is a built-in assertion type or option"},
+		{name: "option", assertName: "template", factory: factory, expectedError: "assertion type `template` is a built-in assertion type or option"},
+		{name: "description option", assertName: "description", factory: factory, expectedError: "assertion type `description` is a built-in assertion type or option"},
+		{name: "message option", assertName: "message", factory: factory, expectedError: "assertion type `message` is a built-in assertion type or option"},
+		{name: "expectFailure option", assertName: "expectFailure", factory: factory, expectedError: "assertion type `expectFailure` is a built-in assertion type or option"},
+		{name: "stage option", assertName: "stage", factory: factory, expectedError: "assertion type `stage` is a built-in assertion type or option"},
+		{name: "registered", assertName: "ownedBy", factory: factory, expectedError: "assertion type `ownedBy` is already registered"},
+		{name: "no factory", assertName: "custom", expectedError: "assertion type `custom` has no validator factory"},
+		{
+			name:          "no pointer",
+			assertName:    "custom",
+			factory:       func() validators.Validatable { return ownerLabelValidator{} },
+			expectedError: "assertion type `custom` validator factory should return a pointer to a struct, got unittest_test.ownerLabelValidator",
+		},
+		{
+			name:          "invalid schema",
+			assertName:    "custom",
+			factory:       factory,
+			options:       AssertionOptions{Schema: map[string]any{"type": "unknown"}},
+			expectedError: "assertion type `custom` schema is invalid: ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := RegisterAssertion(tt.assertName, tt.factory, tt.options)
+			assert.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
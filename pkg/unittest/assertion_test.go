@@ -1,6 +1,9 @@
 package unittest_test
 
 import (
+	"os"
+	"path/filepath"
+	"runtime"
 	"testing"
 
 	"github.com/helm-unittest/helm-unittest/internal/common"
@@ -81,13 +84,32 @@ func TestAssertionUnmarshalFromYAML(t *testing.T) {
 - lengthEqual:
 - matchSnapshot:
 - matchSnapshotRaw:
+- installOrder:
+- notInstallOrder:
+- totalDocuments:
+- uniqueResourceNames:
+- serviceSelectsPods:
+- referencesResolved:
+- ingressBackendsResolved:
+- isHook:
+- containsLine:
+- notContainsLine:
+- expression:
+- matchPolicy:
+- matchReleasePolicy:
+- matchJsonSchema:
+- between:
+- inRange:
+- matchSemver:
+- image:
+- exec:
 `
 
 	a := assert.New(t)
-	assertionsAsMap := make([]map[string]any, 33)
+	assertionsAsMap := make([]map[string]any, 47)
 	common.YmlUnmarshalTestHelper(assertionsYAML, &assertionsAsMap, t)
 
-	assertions := make([]Assertion, 33)
+	assertions := make([]Assertion, 47)
 	common.YmlUnmarshalTestHelper(assertionsYAML, &assertions, t)
 
 	for idx, assertion := range assertions {
@@ -304,6 +326,305 @@ x:
 	validateSucceededTestAssertions(t, assertionsYAML, 15, renderedMap, false)
 }
 
+func TestAssertionCompareAsAssertWhenOk(t *testing.T) {
+	manifestDoc := `
+kind: Deployment
+resources:
+  requests:
+    cpu: 500m
+    memory: 512Mi
+  limits:
+    cpu: 1
+    memory: 1Gi
+timeout: 90s
+`
+	manifest := common.TrustedUnmarshalYAML(manifestDoc)
+	renderedMap := map[string][]common.K8sManifest{
+		"t.yaml": {manifest},
+	}
+
+	assertionsYAML := `
+- template: t.yaml
+  greaterOrEqual:
+    path: resources.limits.cpu
+    value: 500m
+    compareAs: quantity
+- template: t.yaml
+  lessOrEqual:
+    path: resources.requests.memory
+    value: 1Gi
+    compareAs: quantity
+- template: t.yaml
+  between:
+    path: resources.limits.memory
+    min: 512Mi
+    max: 2Gi
+    compareAs: quantity
+- template: t.yaml
+  inRange:
+    path: timeout
+    min: 1m
+    max: 2m
+    compareAs: duration
+- template: t.yaml
+  notLessOrEqual:
+    path: resources.requests.cpu
+    value: 250m
+    compareAs: quantity
+`
+	validateSucceededTestAssertions(t, assertionsYAML, 5, renderedMap, false)
+}
+
+func TestAssertionVersionAssertWhenOk(t *testing.T) {
+	manifestDoc := `
+kind: Pod
+metadata:
+  labels:
+    version: 1.4.0
+spec:
+  containers:
+    - image: envoyproxy/envoy:v1.29.3
+`
+	manifest := common.TrustedUnmarshalYAML(manifestDoc)
+	renderedMap := map[string][]common.K8sManifest{
+		"t.yaml": {manifest},
+	}
+
+	assertionsYAML := `
+- template: t.yaml
+  matchSemver:
+    path: metadata.labels.version
+    constraint: ">=1.2.0 <2.0.0"
+- template: t.yaml
+  matchSemver:
+    path: spec.containers[0].image
+    constraint: ~1.29
+    pattern: ":(.+)$"
+- template: t.yaml
+  image:
+    registry: docker.io
+    repository: envoyproxy/envoy
+    forbidLatest: true
+`
+	validateSucceededTestAssertions(t, assertionsYAML, 3, renderedMap, false)
+}
+
+func TestAssertionDecodeAssertWhenOk(t *testing.T) {
+	manifestDoc := `
+kind: Secret
+data:
+  password: c2VjcmV0
+  config.json: eyJzZXJ2ZXIiOiB7InBvcnQiOiA4MDgwfX0=
+stringData:
+  app.toml: |
+    [server]
+    port = 8080
+  app.ini: |
+    [server]
+    host = localhost
+`
+	manifest := common.TrustedUnmarshalYAML(manifestDoc)
+	renderedMap := map[string][]common.K8sManifest{
+		"t.yaml": {manifest},
+	}
+
+	assertionsYAML := `
+- template: t.yaml
+  matchRegex:
+    path: data.password
+    pattern: ^secret$
+  decode:
+    data.password: base64
+- template: t.yaml
+  equal:
+    path: data["config.json"].server.port
+    value: 8080
+  decode:
+    data["config.json"]: [base64, json]
+- template: t.yaml
+  exists:
+    path: stringData["app.toml"].server.port
+  decode:
+    stringData["app.toml"]: toml
+- template: t.yaml
+  equal:
+    path: stringData["app.ini"].server.host
+    value: localhost
+  decode:
+    stringData["app.ini"]: ini
+- template: t.yaml
+  notExists:
+    path: data.unknown.key
+  decode:
+    data.unknown: yaml
+`
+	validateSucceededTestAssertions(t, assertionsYAML, 5, renderedMap, false)
+}
+
+func TestAssertionQuantifierAssertWhenOk(t *testing.T) {
+	manifestDoc := `
+kind: Deployment
+metadata:
+  name: app
+spec:
+  template:
+    spec:
+      containers:
+        - name: app
+          securityContext:
+            runAsNonRoot: true
+        - name: sidecar
+          securityContext:
+            runAsNonRoot: true
+`
+	renderedMap := map[string][]common.K8sManifest{
+		"t.yaml": {
+			common.TrustedUnmarshalYAML(manifestDoc),
+			{"kind": "Service", "metadata": map[string]any{"name": "app"}},
+		},
+	}
+
+	assertionsYAML := `
+- template: t.yaml
+  every:
+    path: spec.template.spec.containers
+    asserts:
+      - equal:
+          path: securityContext.runAsNonRoot
+          value: true
+      - exists:
+          path: name
+  documentIndex: 0
+- template: t.yaml
+  some:
+    path: spec.template.spec.containers
+    asserts:
+      - equal:
+          path: name
+          value: sidecar
+  documentIndex: 0
+- template: t.yaml
+  none:
+    path: spec.template.spec.containers
+    asserts:
+      - exists:
+          path: securityContext.privileged
+  documentIndex: 0
+- template: t.yaml
+  some:
+    asserts:
+      - isKind:
+          of: Service
+- template: t.yaml
+  none:
+    asserts:
+      - isKind:
+          of: Pod
+- template: t.yaml
+  every:
+    asserts:
+      - equal:
+          path: metadata.name
+          value: app
+      - isKind:
+          of: Pod
+        not: true
+`
+	validateSucceededTestAssertions(t, assertionsYAML, 6, renderedMap, false)
+}
+
+func TestAssertionUnmarshalWhenQuantifierInvalid(t *testing.T) {
+	tests := []struct {
+		name, assertionYAML, expectedError string
+	}{
+		{
+			name:          "without asserts",
+			assertionYAML: "every:\n  path: spec.containers\n",
+			expectedError: "assertion type `every` expects a list of asserts",
+		},
+		{
+			name:          "with nested template",
+			assertionYAML: "some:\n  asserts:\n    - exists:\n        path: name\n      template: t.yaml\n",
+			expectedError: "nested asserts of `some` do not support `template`",
+		},
+		{
+			name:          "with nested release assertion",
+			assertionYAML: "none:\n  asserts:\n    - uniqueResourceNames: {}\n",
+			expectedError: "assertion type `uniqueResourceNames` is not supported in `none`",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertion := new(Assertion)
+			err := common.YmlUnmarshal(tt.assertionYAML, &assertion)
+			assert.EqualError(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestAssertionStructuralAssertWhenOk(t *testing.T) {
+	manifestDoc := `
+kind: Deployment
+spec:
+  template:
+    metadata:
+      annotations:
+        checksum/config: 4f2a
+        team: web
+    spec:
+      containers:
+        - name: app
+          env:
+            - name: B
+              value: "2"
+            - name: A
+              value: "1"
+`
+	manifest := common.TrustedUnmarshalYAML(manifestDoc)
+	renderedMap := map[string][]common.K8sManifest{
+		"t.yaml": {manifest},
+	}
+
+	assertionsYAML := `
+- template: t.yaml
+  equal:
+    path: spec.template.spec.containers[0].env
+    value:
+      - name: A
+        value: "1"
+      - name: B
+        value: "2"
+    ignoreOrder: true
+- template: t.yaml
+  equal:
+    path: spec.template.metadata
+    value:
+      annotations:
+        team: web
+    ignorePaths:
+      - annotations["checksum/config"]
+- template: t.yaml
+  isSubset:
+    path: spec.template.spec.containers[0]
+    content:
+      env:
+        - name: A
+        - name: B
+    ignoreOrder: true
+    ignorePaths:
+      - env[*].value
+- template: t.yaml
+  contains:
+    path: spec.template.spec.containers
+    content:
+      name: app
+    ignorePaths:
+      - env
+`
+	validateSucceededTestAssertions(t, assertionsYAML, 4, renderedMap, false)
+}
+
 func TestAssertionRawAssertWhenOk(t *testing.T) {
 	manifest := common.K8sManifest{common.RAW: "NOTES.txt"}
 	renderedMap := map[string][]common.K8sManifest{
@@ -507,6 +828,116 @@ x:
 	validateSucceededTestAssertions(t, assertionsYAML, 15, renderedMap, true)
 }
 
+func TestAssertionUnmarshaledFromYAMLWithDescription(t *testing.T) {
+	assertionsYAML := `
+- equal:
+  description: PCI requires readOnlyRootFilesystem
+- notEqual:
+  message: names must be unique
+- exists:
+  description: the description wins
+  message: over the message
+- isKind:
+`
+	a := assert.New(t)
+
+	assertions := make([]Assertion, 4)
+	common.YmlUnmarshalTestHelper(assertionsYAML, &assertions, t)
+
+	a.Equal("PCI requires readOnlyRootFilesystem", assertions[0].Description)
+	a.Equal("names must be unique", assertions[1].Description)
+	a.Equal("the description wins", assertions[2].Description)
+	a.Empty(assertions[3].Description)
+}
+
+func TestAssertionUnmarshalWhenOnlyDescription(t *testing.T) {
+	assertion := new(Assertion)
+	err := common.YmlUnmarshal("description: no assertion type\n", &assertion)
+	assert.EqualError(t, err, "no assertion type defined")
+}
+
+func TestAssertionAssertWhenTemplateNotExistedWithDescription(t *testing.T) {
+	renderedMap := map[string][]common.K8sManifest{
+		"existed.yaml": {common.K8sManifest{}},
+	}
+	assertionYAML := `
+template: not-existed.yaml
+equal:
+description: PCI requires readOnlyRootFilesystem
+`
+	assertion := new(Assertion)
+	common.YmlUnmarshalTestHelper(assertionYAML, &assertion, t)
+
+	cfg := AssertionConfigBuilder{
+		TemplatesResult:  renderedMap,
+		SnapshotComparer: fakeSnapshotComparer(true),
+		RenderSucceed:    true,
+	}
+	assertion.WithConfig(cfg.Build())
+	result := assertion.Assert(&results.AssertionResult{Index: 0})
+
+	a := assert.New(t)
+	a.False(result.Passed)
+	a.Equal("PCI requires readOnlyRootFilesystem", result.Description)
+	a.Equal("- asserts[0] `equal` fail: PCI requires readOnlyRootFilesystem", result.Title())
+}
+
+func TestAssertionUnmarshaledFromYAMLWithExpectFailure(t *testing.T) {
+	assertionsYAML := `
+- equal:
+  expectFailure:
+    reason: known bug
+- notEqual:
+  expectFailure:
+- exists:
+`
+	a := assert.New(t)
+
+	assertions := make([]Assertion, 3)
+	common.YmlUnmarshalTestHelper(assertionsYAML, &assertions, t)
+
+	a.Equal(&ExpectFailure{Reason: "known bug"}, assertions[0].ExpectFailure)
+	a.Equal(&ExpectFailure{}, assertions[1].ExpectFailure)
+	a.Nil(assertions[2].ExpectFailure)
+}
+
+func TestAssertionUnmarshalWhenExpectFailureInvalid(t *testing.T) {
+	assertion := new(Assertion)
+	err := common.YmlUnmarshal("equal:\nexpectFailure: true\n", &assertion)
+	assert.EqualError(t, err, "expectFailure should be an object with a reason")
+
+	err = common.YmlUnmarshal("equal:\nexpectFailure:\n  reason: [bug]\n", &assertion)
+	assert.EqualError(t, err, "expectFailure reason should be a string")
+}
+
+func TestAssertionAssertWhenExpectedToFail(t *testing.T) {
+	renderedMap := map[string][]common.K8sManifest{
+		"existed.yaml": {common.K8sManifest{}},
+	}
+	assertionYAML := `
+template: not-existed.yaml
+equal:
+expectFailure:
+  reason: known bug
+`
+	assertion := new(Assertion)
+	common.YmlUnmarshalTestHelper(assertionYAML, &assertion, t)
+
+	cfg := AssertionConfigBuilder{
+		TemplatesResult:  renderedMap,
+		SnapshotComparer: fakeSnapshotComparer(true),
+		RenderSucceed:    true,
+	}
+	assertion.WithConfig(cfg.Build())
+	result := assertion.Assert(&results.AssertionResult{Index: 0})
+
+	a := assert.New(t)
+	a.True(result.Passed)
+	a.True(result.ExpectedFailure)
+	a.Equal("known bug", result.ExpectFailureReason)
+	a.Equal("- asserts[0] `equal` XFAIL", result.Title())
+}
+
 func TestAssertionAssertWhenTemplateNotSpecifiedAndNoDefault(t *testing.T) {
 	manifest := common.K8sManifest{}
 	renderedMap := map[string][]common.K8sManifest{
@@ -565,6 +996,46 @@ equal:
 	}, result)
 }
 
+func TestAssertionAssertWhenDecodeFails(t *testing.T) {
+	manifest := common.K8sManifest{"data": map[string]any{"password": "not base64!"}}
+	renderedMap := map[string][]common.K8sManifest{
+		"template.yaml": {manifest},
+	}
+	assertionYAML := `
+template: template.yaml
+exists:
+  path: data.password
+decode:
+  data.password: base64
+`
+	assertion := new(Assertion)
+	common.YmlUnmarshalTestHelper(assertionYAML, &assertion, t)
+
+	a := assert.New(t)
+	cfg := AssertionConfigBuilder{
+		TemplatesResult:  renderedMap,
+		SnapshotComparer: fakeSnapshotComparer(true),
+		RenderSucceed:    true,
+	}
+	assertion.WithConfig(cfg.Build())
+	result := assertion.Assert(&results.AssertionResult{Index: 0})
+	a.False(result.Passed)
+	a.Equal([]string{"Template:\ttemplate.yaml", "Error:"}, result.FailInfo[:2])
+	a.Contains(result.FailInfo[2], "can not decode 'data.password' as base64")
+}
+
+func TestAssertionUnmarshalWhenDecodeFormatUnsupported(t *testing.T) {
+	assertionYAML := `
+exists:
+  path: data.password
+decode:
+  data.password: xml
+`
+	assertion := new(Assertion)
+	err := common.YmlUnmarshal(assertionYAML, &assertion)
+	assert.EqualError(t, err, "unsupported decode format 'xml', expected one of base64, yaml, json, toml or ini")
+}
+
 func TestAssertionWithSkippedDocument(t *testing.T) {
 	manifestDoc := `
 kind: Fake
@@ -611,3 +1082,134 @@ x:
 		assert.True(t, result.Skipped)
 	}
 }
+
+func TestAssertionReleaseScopedAssertWhenOk(t *testing.T) {
+	renderedMap := map[string][]common.K8sManifest{
+		"chart/templates/deployment.yaml": {
+			common.TrustedUnmarshalYAML("kind: Deployment\nmetadata:\n  name: foo\n"),
+		},
+		"chart/templates/configmap.yaml": {
+			common.TrustedUnmarshalYAML("kind: ConfigMap\nmetadata:\n  name: foo\n"),
+			common.TrustedUnmarshalYAML("kind: MyCustomKind\nmetadata:\n  name: foo\n"),
+		},
+		"chart/templates/NOTES.txt": {
+			{common.RAW: "some notes"},
+		},
+		"chart/crds/crd.yaml": {
+			common.TrustedUnmarshalYAML("kind: CustomResourceDefinition\nmetadata:\n  name: foo\n"),
+		},
+	}
+
+	assertionsYAML := `
+- template: templates/deployment.yaml
+  totalDocuments:
+    count: 4
+- installOrder:
+    resources:
+      - CustomResourceDefinition
+      - ConfigMap/foo
+      - Deployment/foo
+      - MyCustomKind
+- notInstallOrder:
+    resources:
+      - Deployment
+      - ConfigMap
+- uniqueResourceNames: {}
+`
+	validateSucceededTestAssertions(t, assertionsYAML, 4, renderedMap, false)
+}
+
+func TestAssertionReleaseScopedAssertWhenDuplicatedNames(t *testing.T) {
+	renderedMap := map[string][]common.K8sManifest{
+		"chart/templates/configmap.yaml": {
+			common.TrustedUnmarshalYAML("kind: ConfigMap\nmetadata:\n  name: foo\n"),
+		},
+		"chart/templates/other-configmap.yaml": {
+			common.TrustedUnmarshalYAML("kind: ConfigMap\nmetadata:\n  name: foo\n"),
+		},
+	}
+	assertionYAML := `
+uniqueResourceNames: {}
+`
+	assertion := new(Assertion)
+	common.YmlUnmarshalTestHelper(assertionYAML, &assertion, t)
+
+	cfg := AssertionConfigBuilder{
+		TemplatesResult: renderedMap,
+		RenderSucceed:   true,
+	}
+	assertion.WithConfig(cfg.Build())
+	result := assertion.Assert(&results.AssertionResult{Index: 0})
+	assert.Equal(t, &results.AssertionResult{
+		Index: 0,
+		FailInfo: []string{
+			"Expected resource names to be unique, duplicates found:",
+			"\tConfigMap/foo (2 documents)",
+		},
+		Passed:     false,
+		AssertType: "uniqueResourceNames",
+		Not:        false,
+		CustomInfo: "",
+	}, result)
+}
+
+func TestAssertionReferencesResolvedWithClusterObjects(t *testing.T) {
+	renderedMap := map[string][]common.K8sManifest{
+		"chart/templates/pod.yaml": {
+			common.TrustedUnmarshalYAML("kind: Pod\nmetadata:\n  name: foo\nspec:\n  serviceAccountName: foo\n"),
+		},
+	}
+	assertionYAML := `
+referencesResolved: {}
+`
+	assertion := new(Assertion)
+	common.YmlUnmarshalTestHelper(assertionYAML, &assertion, t)
+
+	cfg := AssertionConfigBuilder{
+		TemplatesResult: renderedMap,
+		RenderSucceed:   true,
+		ClusterObjects: []common.K8sManifest{
+			common.TrustedUnmarshalYAML("kind: ServiceAccount\nmetadata:\n  name: foo\n"),
+		},
+	}
+	assertion.WithConfig(cfg.Build())
+	result := assertion.Assert(&results.AssertionResult{Index: 0})
+	assert.True(t, result.Passed, result.FailInfo)
+}
+
+func TestAssertionExecAssertWhenOk(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shell scripts are not supported on windows")
+	}
+	dir := t.TempDir()
+	script := "#!/bin/sh\ngrep -q '\"template\":\"chart/templates/pod.yaml\"' && echo '{\"passed\": true}' || echo '{\"passed\": false}'\n"
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "check.sh"), []byte(script), 0755))
+
+	renderedMap := map[string][]common.K8sManifest{
+		"chart/templates/pod.yaml": {
+			common.TrustedUnmarshalYAML("kind: Pod\nmetadata:\n  name: foo\n"),
+		},
+	}
+	assertionsYAML := `
+- exec: ./check.sh
+  template: chart/templates/pod.yaml
+- exec:
+    cmd: ./check.sh
+    args:
+      - --strict
+  template: chart/templates/pod.yaml
+`
+	assertions := make([]Assertion, 2)
+	common.YmlUnmarshalTestHelper(assertionsYAML, &assertions, t)
+
+	for idx, assertion := range assertions {
+		cfg := AssertionConfigBuilder{
+			TemplatesResult: renderedMap,
+			RenderSucceed:   true,
+			BaseDir:         dir,
+		}
+		assertion.WithConfig(cfg.Build())
+		result := assertion.Assert(&results.AssertionResult{Index: idx})
+		assert.True(t, result.Passed, result.FailInfo)
+	}
+}
@@ -0,0 +1,59 @@
+package unittest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+)
+
+// DiffFrom renders the chart a second time as the baseline of the differential assertions,
+// like `addedDocuments` and `unchangedExcept`. The baseline uses the default values of the chart,
+// unless values files or set values are specified, and the release and capabilities of the test job.
+type DiffFrom struct {
+	Values []string       `yaml:"values"`
+	Set    map[string]any `yaml:"set"`
+}
+
+// baselineConfig returns the config of the test job with a copy of the target chart to render the baseline,
+// since rendering the test job removes the disabled subcharts from the target chart.
+// It is called before the test job is rendered.
+func (d *DiffFrom) baselineConfig(t *TestJob) TestConfig {
+	config := t.configOrDefault()
+	if d != nil {
+		config.targetChart = FullCopyV3Chart(t.chartRoute, config.targetChart.Name(), config.targetChart)
+	}
+	return config
+}
+
+// render renders and post-renders the baseline of the test job with the baseline config,
+// it returns nil without DiffFrom.
+func (d *DiffFrom) render(ctx context.Context, t *TestJob, config TestConfig) (map[string][]common.K8sManifest, error) {
+	if d == nil {
+		return nil, nil
+	}
+
+	baseline := *t
+	baseline.config = config
+	baseline.Values = d.Values
+	baseline.Set = d.Set
+	baseline.globalSet = nil
+	baseline.DiffFrom = nil
+
+	userValues, err := baseline.getUserValues()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the values of the baseline: %w", err)
+	}
+
+	outputOfFiles, _, renderSucceed, err := baseline.renderV3ChartInTime(ctx, []byte(userValues))
+	if err != nil {
+		return nil, fmt.Errorf("unable to render the baseline: %w", err)
+	}
+
+	postRenderedOutputOfFiles, _, _, err := baseline.postRender(ctx, outputOfFiles)
+	if err != nil {
+		return nil, fmt.Errorf("unable to post-render the baseline: %w", err)
+	}
+
+	return baseline.parseAndFilterManifests(postRenderedOutputOfFiles, renderSucceed)
+}
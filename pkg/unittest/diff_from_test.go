@@ -0,0 +1,116 @@
+package unittest_test
+
+import (
+	"testing"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+	. "github.com/helm-unittest/helm-unittest/pkg/unittest"
+	"github.com/helm-unittest/helm-unittest/pkg/unittest/results"
+	"github.com/helm-unittest/helm-unittest/pkg/unittest/snapshot"
+	"github.com/stretchr/testify/assert"
+	"helm.sh/helm/v3/pkg/chart/loader"
+)
+
+func TestV3RunJobWithDiffFromDefaultValues(t *testing.T) {
+	testResult := runJobWithPostRenderer(t, `
+it: should only add the ingress when enabled
+set:
+  ingress.enabled: true
+diffFrom: {}
+asserts:
+  - addedDocuments: [Ingress]
+  - removedDocuments:
+      count: 0
+  - unchangedExcept: []
+`)
+
+	assert.NoError(t, testResult.ExecError)
+	assert.True(t, testResult.Passed, testResult.Stringify())
+}
+
+func TestV3RunJobWithDiffFromSetValues(t *testing.T) {
+	testResult := runJobWithPostRenderer(t, `
+it: should only change the image of the deployment
+set:
+  image.tag: latest
+diffFrom:
+  set:
+    image.tag: stable
+asserts:
+  - changedPaths:
+      resource: Deployment
+      paths:
+        - spec.template.spec.containers[0].image
+  - unchangedExcept:
+      paths:
+        - spec.template.spec.containers[0].image
+  - addedDocuments:
+      count: 0
+`)
+
+	assert.NoError(t, testResult.ExecError)
+	assert.True(t, testResult.Passed, testResult.Stringify())
+}
+
+func TestV3RunJobWithDiffFromFail(t *testing.T) {
+	testResult := runJobWithPostRenderer(t, `
+it: should report the changes
+set:
+  ingress.enabled: true
+  image.tag: latest
+diffFrom: {}
+asserts:
+  - unchangedExcept: []
+`)
+
+	assert.NoError(t, testResult.ExecError)
+	assert.False(t, testResult.Passed)
+	assert.Contains(t, testResult.Stringify(), "Deployment/NAMESPACE/RELEASE-NAME-basic: spec.template.spec.containers[0].image")
+}
+
+func TestV3RunJobWithoutDiffFromFail(t *testing.T) {
+	testResult := runJobWithPostRenderer(t, `
+it: should require the baseline
+asserts:
+  - addedDocuments:
+      count: 0
+`)
+
+	assert.NoError(t, testResult.ExecError)
+	assert.False(t, testResult.Passed)
+	assert.Contains(t, testResult.Stringify(), "expected `diffFrom` to be set on the test, to render the baseline")
+}
+
+func TestV3RunJobWithDiffFromMissingValuesFile(t *testing.T) {
+	testResult := runJobWithPostRenderer(t, `
+it: should fail to read the values of the baseline
+diffFrom:
+  values:
+    - ../../test/data/v3/basic/missing-values.yaml
+asserts:
+  - addedDocuments:
+      count: 0
+`)
+
+	assert.ErrorContains(t, testResult.ExecError, "unable to read the values of the baseline")
+}
+
+func TestV3RunJobWithDiffFromDisabledSubchart(t *testing.T) {
+	c, _ := loader.Load(testV3WithSubChart)
+	var tj TestJob
+	common.YmlUnmarshalTestHelper(`
+it: should remove the documents of the disabled subchart
+set:
+  postgresql.enabled: false
+diffFrom: {}
+asserts:
+  - removedDocuments: {}
+  - unchangedExcept: []
+    not: true
+`, &tj, t)
+	tj.WithConfig(*NewTestConfig(c, &snapshot.Cache{}))
+	testResult := tj.RunV3(&results.TestJobResult{})
+
+	assert.NoError(t, testResult.ExecError)
+	assert.True(t, testResult.Passed, testResult.Stringify())
+}
@@ -0,0 +1,103 @@
+package unittest
+
+import (
+	"fmt"
+
+	"github.com/helm-unittest/helm-unittest/pkg/unittest/results"
+)
+
+// ExpectFailure marks a test job or an assertion as expected to fail, like for a known bug.
+// A failure is reported as XFAIL and passes, while an unexpected pass is reported as XPASS and fails,
+// so the marker gets removed once the bug is fixed.
+type ExpectFailure struct {
+	Reason string `yaml:"reason"`
+}
+
+// parseExpectFailure parse the `expectFailure` object of an assertion definition
+func parseExpectFailure(assertDef map[string]any) (*ExpectFailure, error) {
+	expectFailureDef, ok := assertDef["expectFailure"]
+	if !ok {
+		return nil, nil
+	}
+
+	expectFailure := &ExpectFailure{}
+	if expectFailureDef == nil {
+		return expectFailure, nil
+	}
+
+	expectFailureMap, ok := expectFailureDef.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("expectFailure should be an object with a reason")
+	}
+	if reason, ok := expectFailureMap["reason"]; ok {
+		if expectFailure.Reason, ok = reason.(string); !ok {
+			return nil, fmt.Errorf("expectFailure reason should be a string")
+		}
+	}
+	return expectFailure, nil
+}
+
+// applyToAssertion turns the failed assertion result into an expected failure,
+// and the passed assertion result into an unexpected pass.
+func (e *ExpectFailure) applyToAssertion(result *results.AssertionResult) *results.AssertionResult {
+	if e == nil || result.Skipped {
+		return result
+	}
+
+	result.ExpectFailureReason = e.Reason
+	if !result.Passed {
+		result.Passed = true
+		result.ExpectedFailure = true
+		return result
+	}
+
+	result.Passed = false
+	result.UnexpectedPass = true
+	result.FailInfo = e.unexpectedPassInfo()
+	return result
+}
+
+// applyToTestJob turns the failed test job result into an expected failure, and the passed test job result into
+// an unexpected pass. Without the marker, a passed test job with assertions failed as expected is an expected failure.
+// A test job with an assertion passing unexpectedly fails, so the stale marker of the assertion is reported.
+func (e *ExpectFailure) applyToTestJob(result *results.TestJobResult) *results.TestJobResult {
+	if result.Skipped {
+		return result
+	}
+
+	for _, assertResult := range result.AssertsResult {
+		if assertResult != nil && assertResult.UnexpectedPass {
+			result.Passed = false
+			return result
+		}
+	}
+
+	if e == nil {
+		for _, assertResult := range result.AssertsResult {
+			if assertResult != nil && assertResult.ExpectedFailure {
+				result.ExpectedFailure = result.Passed
+				break
+			}
+		}
+		return result
+	}
+
+	result.ExpectFailureReason = e.Reason
+	if !result.Passed {
+		result.Passed = true
+		result.ExpectedFailure = true
+		return result
+	}
+
+	result.Passed = false
+	result.UnexpectedPass = true
+	return result
+}
+
+// unexpectedPassInfo returns the failure info of an unexpected pass
+func (e *ExpectFailure) unexpectedPassInfo() []string {
+	if e.Reason == "" {
+		return []string{"Expected to fail, but passed"}
+	}
+	return []string{"Expected to fail, but passed:", "\t" + e.Reason}
+}
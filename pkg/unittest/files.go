@@ -1,12 +1,13 @@
 package unittest
 
 import (
+	"io/fs"
+	"path"
 	"path/filepath"
 	"slices"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
-	"github.com/yargevad/filepathx"
 )
 
 const LOG_FILES = "files"
@@ -21,12 +22,19 @@ const LOG_FILES = "files"
 //
 // It returns a slice of file paths and an error if any occurred during processing.
 func GetFiles(chartPath string, filePatterns []string, setAbsolute bool) ([]string, error) {
+	return GetFilesFS(nil, chartPath, filePatterns, setAbsolute)
+}
+
+// GetFilesFS retrieves a list of files matching the given file patterns from fsys, like GetFiles.
+// The paths are slash separated paths of fsys, absolute paths are rooted with a slash at the root of fsys.
+// A nil fsys retrieves the files from the OS file system, like GetFiles.
+func GetFilesFS(fsys fs.FS, chartPath string, filePatterns []string, setAbsolute bool) ([]string, error) {
 	log.WithField(LOG_FILES, "get-files").Debugln("file-patterns:", filePatterns)
 	var filesSet []string
 	basePath := chartPath + "/" // Prepend chartPath with slash
 
 	for _, pattern := range slices.Compact(filePatterns) {
-		matchedFiles, err := processPattern(pattern, basePath)
+		matchedFiles, err := processPattern(fsys, pattern, basePath)
 		if err != nil {
 			return nil, err
 		}
@@ -36,7 +44,12 @@ func GetFiles(chartPath string, filePatterns []string, setAbsolute bool) ([]stri
 	if setAbsolute {
 		// If setAbsolute is true, convert the file paths to absolute paths
 		for i, filePath := range filesSet {
-			if !filepath.IsAbs(filePath) {
+			if isAbsPath(fsys, filePath) {
+				continue
+			}
+			if fsys != nil {
+				filesSet[i] = path.Join("/", filepath.ToSlash(filePath))
+			} else {
 				absPath, _ := filepath.Abs(filePath)
 				filesSet[i] = absPath
 			}
@@ -47,8 +60,8 @@ func GetFiles(chartPath string, filePatterns []string, setAbsolute bool) ([]stri
 }
 
 // processPattern processes a single file pattern and returns the matched files.
-func processPattern(pattern, basePath string) ([]string, error) {
-	if filepath.IsAbs(pattern) {
+func processPattern(fsys fs.FS, pattern, basePath string) ([]string, error) {
+	if isAbsPath(fsys, pattern) {
 		return []string{pattern}, nil // Return absolute paths directly
 	}
 
@@ -59,7 +72,7 @@ func processPattern(pattern, basePath string) ([]string, error) {
 		filePath = filepath.Join(basePath, pattern)
 	}
 
-	files, err := filepathx.Glob(filePath)
+	files, err := globFiles(fsys, filePath)
 	if err != nil {
 		return nil, err
 	}
@@ -313,3 +313,56 @@ func TestGetFiles_GlobError(t *testing.T) {
 	assert.Error(t, err)
 	assert.EqualError(t, err, "syntax error in pattern")
 }
+
+func TestGetFilesFS_WithDifferentPatterns(t *testing.T) {
+	fsys := fstest.MapFS{
+		"chart/a/b/c/first.yaml":    {Data: []byte("hi")},
+		"chart/a/b/c/e/second.yaml": {Data: []byte("hi")},
+		"chart/a/b/third.yaml":      {Data: []byte("hi")},
+		"chart/a/file1.txt":         {Data: []byte("hi")},
+		"chart/a/b/c/file0.txt":     {Data: []byte("hi")},
+		"chart/a/b/file2.json":      {Data: []byte("hi")},
+		"chart/file3.xml":           {Data: []byte("hi")},
+		"other/file4.xml":           {Data: []byte("hi")},
+	}
+
+	tests := []struct {
+		pattern  []string
+		expected []string
+	}{
+		{
+			pattern:  []string{"**/*.yaml"},
+			expected: []string{"/chart/a/b/c/first.yaml", "/chart/a/b/c/e/second.yaml", "/chart/a/b/third.yaml"},
+		},
+		{
+			pattern:  []string{"a/*/file*.json", "a/**/file*.txt"},
+			expected: []string{"/chart/a/b/file2.json", "/chart/a/file1.txt", "/chart/a/b/c/file0.txt"},
+		},
+		{
+			pattern:  []string{"**/*.xml"},
+			expected: []string{"/chart/file3.xml"},
+		},
+		{
+			pattern:  []string{"/other/*.xml"},
+			expected: []string{"/other/*.xml"},
+		},
+		{
+			pattern:  []string{"missing/**/*.xml", "*.log"},
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("with %s identified %d files", strings.Join(tt.pattern, ":"), len(tt.expected)), func(t *testing.T) {
+			files, err := GetFilesFS(fsys, "chart", tt.pattern, true)
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, tt.expected, files)
+		})
+	}
+}
+
+func TestGetFilesFS_GlobError(t *testing.T) {
+	files, err := GetFilesFS(fstest.MapFS{}, "chart", []string{"[**"}, false)
+	assert.Nil(t, files)
+	assert.EqualError(t, err, "syntax error in pattern")
+}
@@ -0,0 +1,201 @@
+package unittest
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/yargevad/filepathx"
+	v3chart "helm.sh/helm/v3/pkg/chart"
+	v3loader "helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/ignore"
+)
+
+var utf8bom = []byte{0xEF, 0xBB, 0xBF}
+
+// fsPath returns the path as path of an fs.FS, slash separated and relative to its root.
+func fsPath(name string) string {
+	cleaned := path.Clean(filepath.ToSlash(name))
+	if cleaned != "/" {
+		cleaned = strings.TrimPrefix(cleaned, "/")
+	}
+	return cleaned
+}
+
+// isAbsPath returns whether the path is absolute, paths of an fs.FS are absolute when rooted with a slash.
+func isAbsPath(fsys fs.FS, name string) bool {
+	if fsys == nil {
+		return filepath.IsAbs(name)
+	}
+	return strings.HasPrefix(filepath.ToSlash(name), "/")
+}
+
+// readFile reads the named file of the fsys, or of the OS file system when fsys is nil.
+func readFile(fsys fs.FS, name string) ([]byte, error) {
+	if fsys == nil {
+		return os.ReadFile(name)
+	}
+	return fs.ReadFile(fsys, fsPath(name))
+}
+
+// statFile returns the file info of the named file of the fsys, or of the OS file system when fsys is nil.
+func statFile(fsys fs.FS, name string) (fs.FileInfo, error) {
+	if fsys == nil {
+		return os.Stat(name)
+	}
+	return fs.Stat(fsys, fsPath(name))
+}
+
+// globFiles returns the files of the fsys matching the pattern, or of the OS file system when fsys is nil.
+// Like on the OS file system, `**` in the pattern matches any number of directories.
+func globFiles(fsys fs.FS, pattern string) ([]string, error) {
+	if fsys == nil {
+		return filepathx.Glob(pattern)
+	}
+
+	pattern = fsPath(pattern)
+	if !strings.Contains(pattern, "**") {
+		return fs.Glob(fsys, pattern)
+	}
+
+	segments := strings.Split(pattern, "/")
+	for _, segment := range segments {
+		if _, err := path.Match(segment, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	// walk from the directory before the first segment with a wildcard
+	root := "."
+	for index, segment := range segments {
+		if strings.ContainsAny(segment, "*?[\\") {
+			if index > 0 {
+				root = path.Join(segments[:index]...)
+			}
+			break
+		}
+	}
+
+	if _, err := fs.Stat(fsys, root); err != nil {
+		return nil, nil
+	}
+
+	matches := make([]string, 0)
+	err := fs.WalkDir(fsys, root, func(name string, _ fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		matched, matchErr := matchSegments(segments, strings.Split(name, "/"))
+		if matchErr != nil {
+			return matchErr
+		}
+		if matched {
+			matches = append(matches, name)
+		}
+		return nil
+	})
+	return matches, err
+}
+
+// matchSegments returns whether the path segments match the pattern segments, `**` matches any number of segments.
+func matchSegments(patterns, segments []string) (bool, error) {
+	if len(patterns) == 0 {
+		return len(segments) == 0, nil
+	}
+
+	if patterns[0] == "**" {
+		for skip := 0; skip <= len(segments); skip++ {
+			matched, err := matchSegments(patterns[1:], segments[skip:])
+			if matched || err != nil {
+				return matched, err
+			}
+		}
+		return false, nil
+	}
+
+	if len(segments) == 0 {
+		return false, nil
+	}
+	matched, err := path.Match(patterns[0], segments[0])
+	if !matched || err != nil {
+		return false, err
+	}
+	return matchSegments(patterns[1:], segments[1:])
+}
+
+// loadChart loads the chart directory or archive at chartPath of the fsys,
+// or of the OS file system when fsys is nil. Like helm, the files matching the `.helmignore` are left out.
+func loadChart(fsys fs.FS, chartPath string) (*v3chart.Chart, error) {
+	if fsys == nil {
+		return v3loader.Load(chartPath)
+	}
+
+	chartDir := fsPath(chartPath)
+	info, err := fs.Stat(fsys, chartDir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		archive, err := fsys.Open(chartDir)
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = archive.Close() }()
+		return v3loader.LoadArchive(archive)
+	}
+
+	rules := ignore.Empty()
+	if content, err := fs.ReadFile(fsys, path.Join(chartDir, ignore.HelmIgnore)); err == nil {
+		if rules, err = ignore.Parse(bytes.NewReader(content)); err != nil {
+			return nil, err
+		}
+	}
+	rules.AddDefaults()
+
+	files := make([]*v3loader.BufferedFile, 0)
+	walkErr := fs.WalkDir(fsys, chartDir, func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if name == chartDir {
+			return nil
+		}
+		relativeName := name
+		if chartDir != "." {
+			relativeName = strings.TrimPrefix(name, chartDir+"/")
+		}
+
+		fileInfo, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if rules.Ignore(relativeName, fileInfo) {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if rules.Ignore(relativeName, fileInfo) {
+			return nil
+		}
+		if !fileInfo.Mode().IsRegular() {
+			return fmt.Errorf("cannot load irregular file %s as it has file mode type bits set", name)
+		}
+
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return fmt.Errorf("error reading %s: %w", relativeName, err)
+		}
+		files = append(files, &v3loader.BufferedFile{Name: relativeName, Data: bytes.TrimPrefix(data, utf8bom)})
+		return nil
+	})
+	if walkErr != nil {
+		return nil, walkErr
+	}
+
+	return v3loader.LoadFiles(files)
+}
@@ -141,3 +141,65 @@ func TestNewFormatterWithOutputFileAndOutputTypeSonar(t *testing.T) {
 	assert.NotNil(sut)
 	assert.DirExists(givenDirectory)
 }
+
+func TestWriteTestOutputWithDescriptionsAsFailureMessage(t *testing.T) {
+	failedJob := createTestJobResult("TestCaseFailure", "", false, false, []*results.AssertionResult{
+		createAssertionResult(0, false, false, false, "isSubset", "AssertionFailure", "", ""),
+	})
+	failedJob.Description = "hardened pods"
+	failedJob.AssertsResult[0].Description = "PCI requires readOnlyRootFilesystem"
+	given := []*results.TestSuiteResult{
+		{
+			DisplayName: "TestingSuite",
+			TestsResult: []*results.TestJobResult{failedJob},
+		},
+	}
+
+	formatters := map[string]Formatter{
+		"junit": NewJUnitReportXML(),
+		"nunit": NewNUnitReportXML(),
+		"xunit": NewXUnitReportXML(),
+		"sonar": NewSonarReportXML(),
+	}
+	for name, sut := range formatters {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			outputFile := filepath.Join(t.TempDir(), name+"_Test_Description_Output.xml")
+			content := string(loadFormatterTestcase(a, outputFile, given, sut))
+			a.Contains(content, "Failed: hardened pods; PCI requires readOnlyRootFilesystem")
+		})
+	}
+}
+
+func TestWriteTestOutputWithExpectedFailureAsSkipped(t *testing.T) {
+	expectedFailureJob := createTestJobResult("TestCaseExpectedFailure", "", true, false, []*results.AssertionResult{
+		createAssertionResult(0, false, false, false, "equal", "AssertionFailure", "", ""),
+	})
+	expectedFailureJob.ExpectedFailure = true
+	expectedFailureJob.ExpectFailureReason = "known bug"
+	unexpectedPassJob := createTestJobResult("TestCaseUnexpectedPass", "", false, false, nil)
+	unexpectedPassJob.UnexpectedPass = true
+	unexpectedPassJob.ExpectFailureReason = "fixed bug"
+	given := []*results.TestSuiteResult{
+		{
+			DisplayName: "TestingSuite",
+			TestsResult: []*results.TestJobResult{expectedFailureJob, unexpectedPassJob},
+		},
+	}
+
+	formatters := map[string]Formatter{
+		"junit": NewJUnitReportXML(),
+		"nunit": NewNUnitReportXML(),
+		"xunit": NewXUnitReportXML(),
+		"sonar": NewSonarReportXML(),
+	}
+	for name, sut := range formatters {
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			outputFile := filepath.Join(t.TempDir(), name+"_Test_ExpectFailure_Output.xml")
+			content := string(loadFormatterTestcase(a, outputFile, given, sut))
+			a.Contains(content, "XFAIL: known bug")
+			a.Contains(content, "XPASS: fixed bug")
+		})
+	}
+}
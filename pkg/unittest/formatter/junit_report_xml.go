@@ -90,10 +90,15 @@ func (j *jUnitReportXML) WriteTestOutput(testSuiteResults []*results.TestSuiteRe
 				testCase.SkipMessage = j.createJUnitSkipMessage(test.StringifyToXmlAttribute())
 			}
 
+			// Write a test failed as expected as skipped, with the reasons
+			if test.ExpectedFailure {
+				testCase.SkipMessage = j.createJUnitSkipMessage(test.ExpectedFailureMessage())
+			}
+
 			// Write when a test is failed
 			if !test.Skipped && !test.Passed && test.ExecError == nil {
 				ts.Failures++
-				testCase.Failure = j.createJUnitFailure("Failed", "", test.StringifyToXmlAttribute())
+				testCase.Failure = j.createJUnitFailure(test.FailureMessage(), "", test.StringifyToXmlAttribute())
 			}
 
 			if !test.Skipped && !test.Passed && test.ExecError != nil {
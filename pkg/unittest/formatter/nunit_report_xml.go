@@ -184,7 +184,8 @@ func (n *nUnitReportXML) processTestCases(testSuiteResult *results.TestSuiteResu
 		testsCount++
 		testCase := n.createNUnitTestCase(determineClassnameFromDisplayName(testSuiteResult.DisplayName), test)
 
-		if test.Skipped {
+		// A test failed as expected is written as skipped, with the reasons
+		if test.Skipped || test.ExpectedFailure {
 			skippedCount++
 			testCase.Reason = &NUnitReason{
 				Message: test.Stringify(),
@@ -201,7 +202,7 @@ func (n *nUnitReportXML) processTestCases(testSuiteResult *results.TestSuiteResu
 				failuresCount++
 			}
 
-			testCase.Failure = n.createNUnitFailure("Failed", test.Stringify())
+			testCase.Failure = n.createNUnitFailure(test.FailureMessage(), test.Stringify())
 		}
 
 		ts.TestCases = append(ts.TestCases, testCase)
@@ -78,12 +78,17 @@ func (j *sonarReportXML) WriteTestOutput(testSuiteResults []*results.TestSuiteRe
 				testCase.Skipped = j.createSonarSkipped("Skipped", test.Stringify())
 			}
 
+			// Write a test failed as expected as skipped, with the reasons
+			if test.ExpectedFailure {
+				testCase.Skipped = j.createSonarSkipped(test.ExpectedFailureMessage(), test.Stringify())
+			}
+
 			if !test.Skipped && !test.Passed && test.ExecError != nil {
 				testCase.Error = j.createSonarError("Error", test.ExecError.Error())
 			}
 
 			if !test.Skipped && !test.Passed && test.ExecError == nil {
-				testCase.Failure = j.createSonarFailure("Failed", test.Stringify())
+				testCase.Failure = j.createSonarFailure(test.FailureMessage(), test.Stringify())
 			}
 
 			ts.TestCases = append(ts.TestCases, testCase)
@@ -185,7 +185,8 @@ func (x *xUnitReportXML) processTestCases(ts *XUnitAssembly, testSuiteResult *re
 }
 
 func (x *xUnitReportXML) updateTestCaseStatus(ts *XUnitAssembly, test *results.TestJobResult, testCase *XUnitTestCase) {
-	if test.Skipped {
+	// A test failed as expected is written as skipped, with the reasons
+	if test.Skipped || test.ExpectedFailure {
 		ts.SkippedTests++
 		ts.TestRuns[0].SkippedTests++
 		testCase.Reason = x.createXUnitSkippedReason(test.Stringify())
@@ -194,7 +195,7 @@ func (x *xUnitReportXML) updateTestCaseStatus(ts *XUnitAssembly, test *results.T
 	}
 
 	if !test.Passed {
-		testCase.Failure = x.createXUnitFailure(XUnitValidationMethod, "Failed", test.Stringify())
+		testCase.Failure = x.createXUnitFailure(XUnitValidationMethod, test.FailureMessage(), test.Stringify())
 		if test.ExecError != nil {
 			ts.ErrorsTests++
 			testCase.Failure.ExceptionType = fmt.Sprintf("%s-%s", XUnitValidationMethod, "Error")
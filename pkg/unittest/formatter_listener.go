@@ -0,0 +1,58 @@
+package unittest
+
+import (
+	"os"
+	"time"
+
+	"github.com/helm-unittest/helm-unittest/pkg/unittest/formatter"
+	"github.com/helm-unittest/helm-unittest/pkg/unittest/printer"
+	"github.com/helm-unittest/helm-unittest/pkg/unittest/results"
+	log "github.com/sirupsen/logrus"
+)
+
+// formatterListener collects the results of the test suites and writes them with the formatter
+// to the output file, when the test run is finished.
+type formatterListener struct {
+	BaseRunListener
+	formatter   formatter.Formatter
+	outputFile  string
+	printer     *printer.Printer
+	testResults []*results.TestSuiteResult
+}
+
+// newFormatterListener create a formatterListener, errors writing the output file are printed with the printer
+func newFormatterListener(formatter formatter.Formatter, outputFile string, printer *printer.Printer) *formatterListener {
+	return &formatterListener{formatter: formatter, outputFile: outputFile, printer: printer}
+}
+
+// OnSuiteFinished collect the result of the test suite, test suites which failed to be parsed
+// or to store their snapshots are only printed.
+func (l *formatterListener) OnSuiteFinished(result *results.TestSuiteResult) {
+	if result.ExecError != nil {
+		return
+	}
+	l.testResults = append(l.testResults, result)
+}
+
+// OnRunFinished write the collected results to the output file
+func (l *formatterListener) OnRunFinished(bool, time.Duration) {
+	if err := l.writeTestOutput(); err != nil && l.printer != nil {
+		printErroredHeader(l.printer, err)
+	}
+}
+
+func (l *formatterListener) writeTestOutput() error {
+	// Create outputfile for testsuite
+	writer, ferr := os.Create(l.outputFile)
+	if ferr != nil {
+		return ferr
+	}
+	defer func() {
+		werr := writer.Close()
+		if werr != nil {
+			log.WithField(LOG_TEST_RUNNER, "write-test-output").Errorf("Error closing output file: %s", werr)
+		}
+	}()
+
+	return l.formatter.WriteTestOutput(l.testResults, true, writer)
+}
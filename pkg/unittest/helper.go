@@ -0,0 +1,84 @@
+package unittest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	v3chart "helm.sh/helm/v3/pkg/chart"
+)
+
+// helperTemplateName the virtual template which renders the helper of a test job.
+const helperTemplateName = "templates/helm-unittest-helper.txt"
+
+// helperRootContext the context value which is replaced by the root context (`$`) of the chart.
+const helperRootContext = "$"
+
+// HelperConfig defines a named template (include) or tpl expression to render,
+// instead of rendering the templates of the chart.
+type HelperConfig struct {
+	Include string         `yaml:"include"`
+	Tpl     string         `yaml:"tpl"`
+	Context map[string]any `yaml:"context"`
+}
+
+// validate checks if exactly one of include or tpl is defined.
+func (h *HelperConfig) validate() error {
+	if (h.Include == "") == (h.Tpl == "") {
+		return errors.New("helper requires exactly one of 'include' or 'tpl' to be defined")
+	}
+	return nil
+}
+
+// template creates the template rendering the helper.
+// Without a context the root context of the chart is used, otherwise the context is built from
+// the built-in objects (like .Values and .Release) extended with the given context.
+// Context values set to `$` are replaced by the root context.
+func (h *HelperConfig) template() (string, error) {
+	var tpl strings.Builder
+	tpl.WriteString(`{{- $context := . }}`)
+
+	if len(h.Context) > 0 {
+		contextJson, err := json.Marshal(h.Context)
+		if err != nil {
+			return "", err
+		}
+
+		tpl.WriteString(`{{- $context = dict "Values" .Values "Release" .Release "Chart" .Chart "Capabilities" .Capabilities "Template" .Template "Files" .Files "Subcharts" .Subcharts }}`)
+		fmt.Fprintf(&tpl, `{{- range $key, $value := fromJson %s }}`, strconv.Quote(string(contextJson)))
+		fmt.Fprintf(&tpl, `{{- if eq (toString $value) %s }}{{- $_ := set $context $key $ }}`, strconv.Quote(helperRootContext))
+		tpl.WriteString(`{{- else }}{{- $_ := set $context $key $value }}{{- end }}{{- end }}`)
+	}
+
+	if h.Include != "" {
+		fmt.Fprintf(&tpl, `{{- include %s $context }}`, strconv.Quote(h.Include))
+	} else {
+		fmt.Fprintf(&tpl, `{{- tpl %s $context }}`, strconv.Quote(h.Tpl))
+	}
+
+	return tpl.String(), nil
+}
+
+// addTo adds the helper template to the chart.
+// Library charts only render partials, so the chart is rendered as an application chart instead.
+// The metadata is shared with the chart the target chart is copied from, so it is copied before changing the type.
+func (h *HelperConfig) addTo(targetChart *v3chart.Chart) error {
+	tpl, err := h.template()
+	if err != nil {
+		return err
+	}
+
+	if strings.EqualFold(targetChart.Metadata.Type, "library") {
+		metadata := *targetChart.Metadata
+		metadata.Type = "application"
+		targetChart.Metadata = &metadata
+	}
+
+	targetChart.Templates = append(targetChart.Templates, &v3chart.File{
+		Name: helperTemplateName,
+		Data: []byte(tpl),
+	})
+	return nil
+}
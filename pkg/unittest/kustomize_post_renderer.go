@@ -0,0 +1,214 @@
+package unittest
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"fmt"
+	"io/fs"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/kustomize/api/krusty"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+	"sigs.k8s.io/kustomize/kyaml/kio"
+	"sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+const (
+	// defaultKustomizeResource the file name of the rendered manifests, like in the helm post-rendering example
+	defaultKustomizeResource = "all.yaml"
+	// kustomizeFileAnnotation the annotation keeping the file of the rendered manifests through kustomize
+	kustomizeFileAnnotation = "helm-unittest.io/file"
+	// kustomizeAddedFile the template file of the manifests added by the kustomization
+	kustomizeAddedFile = "kustomization.yaml"
+)
+
+// KustomizeConfig applies a local kustomization to the rendered manifests in-process,
+// without the kustomize binary.
+type KustomizeConfig struct {
+	// Dir is the directory of the kustomization, relative to the test suite file
+	Dir string `yaml:"dir"`
+	// Resource is the file name the kustomization lists to include the rendered manifests, defaults to all.yaml
+	Resource string `yaml:"resource"`
+}
+
+// kustomizePostRenderer builds the kustomization with the rendered manifests,
+// the file of each rendered manifest is kept with an annotation.
+type kustomizePostRenderer struct {
+	ctx      context.Context
+	dir      string
+	resource string
+	// the file of the manifests added by the kustomization, like generated config maps
+	addedFile string
+}
+
+// newKustomizePostRenderer create the post-renderer of the kustomization, the directory is relative to baseDir.
+// The manifests added by the kustomization are put in the kustomization.yaml template of the chart at chartRoute.
+// The kustomization is read from disk, so it is not supported with a FileSystem (fsys).
+func newKustomizePostRenderer(ctx context.Context, cfg KustomizeConfig, fsys fs.FS, baseDir, chartRoute string) (*kustomizePostRenderer, error) {
+	if cfg.Dir == "" {
+		return nil, fmt.Errorf("kustomize post-renderer requires a dir")
+	}
+	if fsys != nil {
+		return nil, fmt.Errorf("kustomize post-renderer is not supported with a FileSystem, the kustomization %s is read from disk", cfg.Dir)
+	}
+
+	dir := cfg.Dir
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(baseDir, dir)
+	}
+
+	return &kustomizePostRenderer{
+		ctx:       ctx,
+		dir:       dir,
+		resource:  cmp.Or(cfg.Resource, defaultKustomizeResource),
+		addedFile: filepath.ToSlash(filepath.Join(chartRoute, getTemplateFileName(kustomizeAddedFile))),
+	}, nil
+}
+
+// Run implement postrender.PostRenderer, the rendered manifests are merged by MergeAndPostRender.
+// Files without kubernetes objects, like NOTES.txt, are kept as rendered.
+// The build can not be interrupted, so the job timeout is checked before and after it.
+func (p *kustomizePostRenderer) Run(renderedManifests *bytes.Buffer) (*bytes.Buffer, error) {
+	if err := p.ctx.Err(); err != nil {
+		return nil, fmt.Errorf("kustomize post-renderer cancelled: %w", err)
+	}
+
+	resources, keptFiles, err := annotateManifestsWithFile(SplitManifests(renderedManifests))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the rendered manifests: %w", err)
+	}
+
+	onDisk := filesys.MakeFsOnDisk()
+	dir, _, err := onDisk.CleanedAbs(p.dir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find kustomization at %s: %w", p.dir, err)
+	}
+	fSys := &kustomizeFileSystem{
+		FileSystem:   onDisk,
+		renderedDir:  dir,
+		renderedFile: p.resource,
+		rendered:     resources,
+	}
+
+	resMap, err := krusty.MakeKustomizer(krusty.MakeDefaultOptions()).Run(fSys, dir.String())
+	if ctxErr := p.ctx.Err(); ctxErr != nil {
+		return nil, fmt.Errorf("kustomize post-renderer cancelled: %w", ctxErr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("kustomize build of %s failed: %w", p.dir, err)
+	}
+
+	files := make([]string, 0)
+	manifestsOfFiles := make(map[string][]string)
+	for _, res := range resMap.Resources() {
+		file := res.GetAnnotations()[kustomizeFileAnnotation]
+		if file == "" {
+			file = p.addedFile
+		}
+		if err := removeFileAnnotation(&res.RNode); err != nil {
+			return nil, err
+		}
+		manifest, err := res.AsYAML()
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := manifestsOfFiles[file]; !ok {
+			files = append(files, file)
+		}
+		manifestsOfFiles[file] = append(manifestsOfFiles[file], string(manifest))
+	}
+
+	postRendered := &bytes.Buffer{}
+	for _, file := range files {
+		postRendered.WriteString(yamlFileSeparator + " " + file + "\n")
+		postRendered.WriteString(strings.Join(manifestsOfFiles[file], "---\n"))
+	}
+	for _, file := range slices.Sorted(maps.Keys(keptFiles)) {
+		postRendered.WriteString(yamlFileSeparator + " " + file + "\n")
+		postRendered.WriteString(keptFiles[file])
+	}
+	return postRendered, nil
+}
+
+// annotateManifestsWithFile returns the kubernetes objects of the files as one YAML stream,
+// with the file of each object in the kustomizeFileAnnotation, and the files without kubernetes objects.
+func annotateManifestsWithFile(manifestsOfFiles map[string]string) ([]byte, map[string]string, error) {
+	nodes := make([]*yaml.RNode, 0)
+	keptFiles := make(map[string]string)
+	for _, file := range slices.Sorted(maps.Keys(manifestsOfFiles)) {
+		fileNodes, err := kio.FromBytes([]byte(manifestsOfFiles[file]))
+		if err != nil || !allKubernetesObjects(fileNodes) {
+			keptFiles[file] = manifestsOfFiles[file]
+			continue
+		}
+		for _, node := range fileNodes {
+			if err := node.PipeE(yaml.SetAnnotation(kustomizeFileAnnotation, file)); err != nil {
+				return nil, nil, fmt.Errorf("%s: %w", file, err)
+			}
+		}
+		nodes = append(nodes, fileNodes...)
+	}
+
+	resources, err := kio.StringAll(nodes)
+	return []byte(resources), keptFiles, err
+}
+
+// removeFileAnnotation removes the kustomizeFileAnnotation, and the annotations when no others are left
+func removeFileAnnotation(node *yaml.RNode) error {
+	if _, err := node.Pipe(yaml.ClearAnnotation(kustomizeFileAnnotation)); err != nil {
+		return err
+	}
+	return node.PipeE(
+		yaml.Lookup(yaml.MetadataField),
+		yaml.FieldClearer{Name: yaml.AnnotationsField, IfEmpty: true})
+}
+
+// allKubernetesObjects returns whether all nodes are kubernetes objects with a kind
+func allKubernetesObjects(nodes []*yaml.RNode) bool {
+	for _, node := range nodes {
+		if node.YNode().Kind != yaml.MappingNode || node.GetKind() == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// kustomizeFileSystem is the file system on disk, with the rendered manifests
+// as the resource file in the kustomization directory.
+type kustomizeFileSystem struct {
+	filesys.FileSystem
+	renderedDir  filesys.ConfirmedDir
+	renderedFile string
+	rendered     []byte
+}
+
+// isRendered returns whether the path is the resource file of the rendered manifests
+func (f *kustomizeFileSystem) isRendered(path string) bool {
+	return filepath.Clean(path) == f.renderedDir.Join(f.renderedFile)
+}
+
+func (f *kustomizeFileSystem) CleanedAbs(path string) (filesys.ConfirmedDir, string, error) {
+	if f.isRendered(path) {
+		return f.renderedDir, f.renderedFile, nil
+	}
+	return f.FileSystem.CleanedAbs(path)
+}
+
+func (f *kustomizeFileSystem) Exists(path string) bool {
+	return f.isRendered(path) || f.FileSystem.Exists(path)
+}
+
+func (f *kustomizeFileSystem) IsDir(path string) bool {
+	return !f.isRendered(path) && f.FileSystem.IsDir(path)
+}
+
+func (f *kustomizeFileSystem) ReadFile(path string) ([]byte, error) {
+	if f.isRendered(path) {
+		return f.rendered, nil
+	}
+	return f.FileSystem.ReadFile(path)
+}
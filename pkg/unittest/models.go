@@ -1,6 +1,9 @@
 package unittest
 
 import (
+	"context"
+	"io/fs"
+
 	"github.com/helm-unittest/helm-unittest/internal/common"
 	"github.com/helm-unittest/helm-unittest/pkg/unittest/snapshot"
 	"github.com/helm-unittest/helm-unittest/pkg/unittest/validators"
@@ -16,7 +19,9 @@ type TestConfig struct {
 	isSkipEmptyTemplate    bool
 	isSkipSchemaValidation bool
 	postRenderer           PostRendererConfig
+	postRenderers          []PostRendererConfig
 	includeCrds            bool
+	fileSystem             fs.FS
 }
 
 func NewTestConfig(chart *v3chart.Chart, cache *snapshot.Cache, options ...func(*TestConfig)) *TestConfig {
@@ -66,6 +71,13 @@ func WithPostRendererConfig(config PostRendererConfig) LoadTestOptionsFunc {
 	}
 }
 
+// WithPostRenderers runs the chain of post-renderers, after the post-renderer of WithPostRendererConfig.
+func WithPostRenderers(configs []PostRendererConfig) LoadTestOptionsFunc {
+	return func(c *TestConfig) {
+		c.postRenderers = configs
+	}
+}
+
 func WithSkipEmptyTemplate(config bool) LoadTestOptionsFunc {
 	return func(c *TestConfig) {
 		c.isSkipEmptyTemplate = config
@@ -84,8 +96,17 @@ func WithSkipSchemaValidation(skip bool) LoadTestOptionsFunc {
 	}
 }
 
+// WithFileSystem reads the values files, and the files of assertions and post-renderers, from fsys instead of the OS file system.
+func WithFileSystem(fsys fs.FS) LoadTestOptionsFunc {
+	return func(c *TestConfig) {
+		c.fileSystem = fsys
+	}
+}
+
 type AssertionConfig struct {
 	templatesResult        map[string][]common.K8sManifest
+	stagesResult           map[string]map[string][]common.K8sManifest
+	baselineResult         map[string][]common.K8sManifest
 	snapshotComparer       validators.SnapshotComparer
 	renderSucceed          bool
 	failFast               bool
@@ -93,6 +114,22 @@ type AssertionConfig struct {
 	isSkipSchemaValidation bool
 	didPostRender          bool
 	renderError            error
+	clusterObjects         []common.K8sManifest
+	renderContext          validators.RenderContext
+	baseDir                string
+	fileSystem             fs.FS
+	jobContext             context.Context
+}
+
+// ofStage returns the config with the manifests of the stage, the post-rendered manifests when the stage is not set.
+func (c AssertionConfig) ofStage(stage string) AssertionConfig {
+	manifests, ok := c.stagesResult[stage]
+	if !ok {
+		return c
+	}
+	c.templatesResult = manifests
+	c.didPostRender = c.didPostRender && stage != stageRendered
+	return c
 }
 
 // AssertionConfigBuilder Required to simplify tests
@@ -105,6 +142,12 @@ type AssertionConfigBuilder struct {
 	RenderError            error
 	IsSkipEmptyTemplate    bool
 	IsSkipSchemaValidation bool
+	ClusterObjects         []common.K8sManifest
+	RenderContext          validators.RenderContext
+	BaseDir                string
+	FileSystem             fs.FS
+	JobContext             context.Context
+	BaselineResult         map[string][]common.K8sManifest
 }
 
 func (b AssertionConfigBuilder) Build() AssertionConfig {
@@ -117,5 +160,11 @@ func (b AssertionConfigBuilder) Build() AssertionConfig {
 		renderError:            b.RenderError,
 		isSkipEmptyTemplate:    b.IsSkipEmptyTemplate,
 		isSkipSchemaValidation: b.IsSkipSchemaValidation,
+		clusterObjects:         b.ClusterObjects,
+		renderContext:          b.RenderContext,
+		baseDir:                b.BaseDir,
+		fileSystem:             b.FileSystem,
+		jobContext:             b.JobContext,
+		baselineResult:         b.BaselineResult,
 	}
 }
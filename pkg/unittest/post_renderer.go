@@ -0,0 +1,192 @@
+package unittest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"helm.sh/helm/v3/pkg/postrender"
+)
+
+const (
+	// stageRendered the assertion stage of the rendered manifests, before post-rendering
+	stageRendered = "rendered"
+	// stagePostRendered the assertion stage of the manifests after all post-renderers, the default
+	stagePostRendered = "postRendered"
+)
+
+// waitDelay the time to wait for the output of a killed post-renderer, when its children keep the output open
+const waitDelay = time.Second
+
+// newPostRenderer create the post-renderer of the config, either the kustomization or the command.
+// The fsys is the FileSystem of the test runner, nil when the files are read from the OS file system.
+func newPostRenderer(ctx context.Context, cfg PostRendererConfig, fsys fs.FS, baseDir, chartRoute string) (postrender.PostRenderer, error) {
+	if cfg.Kustomize == nil {
+		return newExecPostRenderer(ctx, cfg, fsys, baseDir)
+	}
+	if cfg.Cmd != "" {
+		return nil, errors.New("post-renderer should either have a cmd or kustomize")
+	}
+	// The kustomization is built in-process, the job timeout applies instead.
+	if cfg.Timeout != "" || len(cfg.Env) > 0 || cfg.WorkingDir != "" {
+		return nil, errors.New("kustomize post-renderer does not support timeout, env or workingDir")
+	}
+	return newKustomizePostRenderer(ctx, *cfg.Kustomize, fsys, baseDir, chartRoute)
+}
+
+// postRendererChain returns the post-renderers to run in order, the post-renderer followed by the list of post-renderers.
+func postRendererChain(postRenderer PostRendererConfig, postRenderers []PostRendererConfig) []PostRendererConfig {
+	chain := make([]PostRendererConfig, 0, len(postRenderers)+1)
+	if postRenderer.isSet() {
+		chain = append(chain, postRenderer)
+	}
+	return append(chain, postRenderers...)
+}
+
+// validatePostRendererChain validates each post-renderer is set, and the names are unique and not an assertion stage.
+func validatePostRendererChain(chain []PostRendererConfig) error {
+	names := make(map[string]bool, len(chain))
+	for index, cfg := range chain {
+		if !cfg.isSet() {
+			return fmt.Errorf("post-renderer %d should have a cmd or kustomize", index+1)
+		}
+		if cfg.Name == "" {
+			continue
+		}
+		if cfg.Name == stageRendered || cfg.Name == stagePostRendered {
+			return fmt.Errorf("post-renderer name `%s` is reserved for the assertion stage", cfg.Name)
+		}
+		if names[cfg.Name] {
+			return fmt.Errorf("post-renderer name `%s` is not unique", cfg.Name)
+		}
+		names[cfg.Name] = true
+	}
+	return nil
+}
+
+// execPostRenderer runs the post-renderer command, like the helm exec post-renderer,
+// with the environment and working directory of the PostRendererConfig and cancelled on timeout.
+type execPostRenderer struct {
+	ctx        context.Context
+	binaryPath string
+	args       []string
+	env        []string
+	workingDir string
+	timeout    time.Duration
+}
+
+// newExecPostRenderer create the post-renderer of the config, the working directory is relative to baseDir.
+// It returns an error if the command can not be found or the timeout is invalid.
+// The command runs on the OS file system, so with a FileSystem (fsys) the command should be in the PATH
+// and the working directory can not be set.
+func newExecPostRenderer(ctx context.Context, cfg PostRendererConfig, fsys fs.FS, baseDir string) (*execPostRenderer, error) {
+	if fsys != nil {
+		if strings.ContainsRune(cfg.Cmd, '/') || strings.ContainsRune(cfg.Cmd, filepath.Separator) {
+			return nil, fmt.Errorf("post-renderer command %s can not be run from the FileSystem, only commands in the PATH are supported with a FileSystem", cfg.Cmd)
+		}
+		if cfg.WorkingDir != "" {
+			return nil, errors.New("post-renderer workingDir is not supported with a FileSystem")
+		}
+	}
+
+	timeout, err := parseTimeout(cfg.Timeout)
+	if err != nil {
+		return nil, fmt.Errorf("invalid post-renderer timeout: %w", err)
+	}
+
+	binaryPath, err := exec.LookPath(cfg.Cmd)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find binary at %s: %w", cfg.Cmd, err)
+	}
+	if binaryPath, err = filepath.Abs(binaryPath); err != nil {
+		return nil, err
+	}
+
+	workingDir := cfg.WorkingDir
+	if workingDir != "" && !filepath.IsAbs(workingDir) {
+		workingDir = filepath.Join(baseDir, workingDir)
+	}
+
+	var env []string
+	if len(cfg.Env) > 0 {
+		env = os.Environ()
+		for _, name := range slices.Sorted(maps.Keys(cfg.Env)) {
+			env = append(env, fmt.Sprintf("%s=%s", name, cfg.Env[name]))
+		}
+	}
+
+	return &execPostRenderer{
+		ctx:        ctx,
+		binaryPath: binaryPath,
+		args:       cfg.ArgSlice,
+		env:        env,
+		workingDir: workingDir,
+		timeout:    timeout,
+	}, nil
+}
+
+// Run implement postrender.PostRenderer, the rendered manifests are passed on stdin.
+func (p *execPostRenderer) Run(renderedManifests *bytes.Buffer) (*bytes.Buffer, error) {
+	ctx := p.ctx
+	if p.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.timeout)
+		defer cancel()
+	}
+
+	cmd := exec.CommandContext(ctx, p.binaryPath, p.args...)
+	cmd.Env = p.env
+	cmd.Dir = p.workingDir
+	cmd.WaitDelay = waitDelay
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	postRendered := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd.Stdout = postRendered
+	cmd.Stderr = stderr
+
+	go func() {
+		defer func() { _ = stdin.Close() }()
+		_, _ = io.Copy(stdin, renderedManifests)
+	}()
+
+	err = cmd.Run()
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		if p.ctx.Err() == nil {
+			return nil, fmt.Errorf("post-renderer %s timed out after %s: %w", p.binaryPath, p.timeout, ctxErr)
+		}
+		return nil, fmt.Errorf("post-renderer %s cancelled: %w", p.binaryPath, ctxErr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error while running command %s. error output:\n%s: %w", p.binaryPath, stderr.String(), err)
+	}
+	return postRendered, nil
+}
+
+// parseTimeout parse the timeout duration, an empty timeout means no timeout.
+func parseTimeout(timeout string) (time.Duration, error) {
+	if timeout == "" {
+		return 0, nil
+	}
+	duration, err := time.ParseDuration(timeout)
+	if err != nil {
+		return 0, err
+	}
+	if duration < 0 {
+		return 0, errors.New("timeout must not be negative")
+	}
+	return duration, nil
+}
@@ -0,0 +1,442 @@
+package unittest_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+	. "github.com/helm-unittest/helm-unittest/pkg/unittest"
+	"github.com/helm-unittest/helm-unittest/pkg/unittest/results"
+	"github.com/helm-unittest/helm-unittest/pkg/unittest/snapshot"
+	"github.com/stretchr/testify/assert"
+	"helm.sh/helm/v3/pkg/chart/loader"
+)
+
+func writePostRenderer(t *testing.T, script string) string {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("shell scripts are not supported on windows")
+	}
+	postRenderer := filepath.Join(t.TempDir(), "post-render.sh")
+	assert.NoError(t, os.WriteFile(postRenderer, []byte(script), 0755))
+	return postRenderer
+}
+
+func runJobWithPostRenderer(t *testing.T, manifest string) *results.TestJobResult {
+	t.Helper()
+	c, _ := loader.Load(testV3BasicChart)
+	var tj TestJob
+	common.YmlUnmarshalTestHelper(manifest, &tj, t)
+	tj.WithConfig(*NewTestConfig(c, &snapshot.Cache{}))
+	return tj.RunV3(&results.TestJobResult{})
+}
+
+func TestV3RunJobWithPostRendererEnvAndWorkingDir(t *testing.T) {
+	postRenderer := writePostRenderer(t, "#!/bin/sh\nsed \"s/^kind: Deployment/kind: $(cat kind.txt)$KIND_SUFFIX/\"\n")
+	workingDir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(workingDir, "kind.txt"), []byte("Rollout"), 0644))
+
+	testResult := runJobWithPostRenderer(t, fmt.Sprintf(`
+it: should post-render in the working directory with the environment
+template: templates/deployment.yaml
+postRenderer:
+  cmd: %s
+  timeout: 10s
+  workingDir: %s
+  env:
+    KIND_SUFFIX: V2
+asserts:
+  - equal:
+      path: kind
+      value: RolloutV2
+`, postRenderer, workingDir))
+
+	assert.NoError(t, testResult.ExecError)
+	assert.True(t, testResult.Passed, testResult.Stringify())
+}
+
+func TestV3RunJobWithPostRendererTimeout(t *testing.T) {
+	postRenderer := writePostRenderer(t, "#!/bin/sh\nexec sleep 10\n")
+
+	testResult := runJobWithPostRenderer(t, fmt.Sprintf(`
+it: should stop the hanging post-renderer
+postRenderer:
+  cmd: %s
+  timeout: 100ms
+asserts:
+  - hasDocuments:
+      count: 1
+`, postRenderer))
+
+	assert.ErrorIs(t, testResult.ExecError, context.DeadlineExceeded)
+	assert.ErrorContains(t, testResult.ExecError, "timed out after 100ms")
+	assert.False(t, testResult.Passed)
+}
+
+func TestV3RunJobWithTimeoutWhilePostRendering(t *testing.T) {
+	postRenderer := writePostRenderer(t, "#!/bin/sh\nexec sleep 10\n")
+
+	testResult := runJobWithPostRenderer(t, fmt.Sprintf(`
+it: should stop the job when it takes too long
+timeout: 100ms
+postRenderer:
+  cmd: %s
+asserts:
+  - hasDocuments:
+      count: 1
+`, postRenderer))
+
+	assert.ErrorIs(t, testResult.ExecError, context.DeadlineExceeded)
+	assert.EqualError(t, testResult.ExecError, "test job timed out after 100ms while post-rendering: context deadline exceeded")
+	assert.False(t, testResult.Passed)
+}
+
+func TestV3RunJobWithTimeoutWhileAsserting(t *testing.T) {
+	command := writePostRenderer(t, "#!/bin/sh\nexec sleep 10\n")
+
+	testResult := runJobWithPostRenderer(t, fmt.Sprintf(`
+it: should stop the exec assertion when the job takes too long
+timeout: 500ms
+template: templates/deployment.yaml
+asserts:
+  - exec:
+      cmd: %s
+`, command))
+
+	assert.ErrorIs(t, testResult.ExecError, context.DeadlineExceeded)
+	assert.EqualError(t, testResult.ExecError, "test job timed out after 500ms while asserting: context deadline exceeded")
+	assert.False(t, testResult.Passed)
+	assert.Contains(t, testResult.Stringify(), "stopped as the test job timed out")
+}
+
+func TestV3RunJobWithTimeoutInTime(t *testing.T) {
+	testResult := runJobWithPostRenderer(t, `
+it: should render within the timeout
+timeout: 1m
+template: templates/deployment.yaml
+asserts:
+  - isKind:
+      of: Deployment
+`)
+
+	assert.NoError(t, testResult.ExecError)
+	assert.True(t, testResult.Passed, testResult.Stringify())
+}
+
+func TestV3RunJobWithInvalidTimeout(t *testing.T) {
+	testResult := runJobWithPostRenderer(t, `
+it: should fail on the invalid timeout
+timeout: soon
+asserts:
+  - hasDocuments:
+      count: 1
+`)
+
+	assert.ErrorContains(t, testResult.ExecError, "invalid test job timeout")
+}
+
+func TestV3RunJobWithInvalidPostRendererTimeout(t *testing.T) {
+	testResult := runJobWithPostRenderer(t, `
+it: should fail on the negative post-renderer timeout
+postRenderer:
+  cmd: cat
+  timeout: -1s
+asserts:
+  - hasDocuments:
+      count: 1
+`)
+
+	assert.EqualError(t, testResult.ExecError, "invalid post-renderer timeout: timeout must not be negative")
+}
+
+func TestV3RunSuiteWithTimeoutInheritedByJobs(t *testing.T) {
+	postRenderer := writePostRenderer(t, "#!/bin/sh\nexec sleep 10\n")
+	suiteDoc := fmt.Sprintf(`
+suite: test suite with a timeout
+templates:
+  - templates/deployment.yaml
+timeout: 100ms
+postRenderer:
+  cmd: %s
+tests:
+  - it: should time out
+    asserts:
+      - isKind:
+          of: Deployment
+`, postRenderer)
+	testSuite := TestSuite{}
+	common.YmlUnmarshalTestHelper(suiteDoc, &testSuite, t)
+
+	c, _ := loader.Load(testV3BasicChart)
+	suiteResult := testSuite.RunV3(c, &snapshot.Cache{}, true, "", &results.TestSuiteResult{})
+
+	assert.False(t, suiteResult.Passed)
+	assert.Len(t, suiteResult.TestsResult, 1)
+	assert.ErrorContains(t, suiteResult.TestsResult[0].ExecError, "test job timed out after 100ms while post-rendering")
+}
+
+func writeKustomization(t *testing.T, kustomization string) string {
+	t.Helper()
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "kustomization.yaml"), []byte(kustomization), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "extra.yaml"), []byte(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: extra
+`), 0644))
+	return dir
+}
+
+func TestV3RunJobWithKustomizePostRenderer(t *testing.T) {
+	dir := writeKustomization(t, `
+resources:
+  - all.yaml
+  - extra.yaml
+labels:
+  - pairs:
+      environment: prod
+patches:
+  - target:
+      kind: Deployment
+    patch: |-
+      - op: replace
+        path: /spec/replicas
+        value: 3
+`)
+
+	testResult := runJobWithPostRenderer(t, fmt.Sprintf(`
+it: should apply the kustomization and keep the files
+postRenderer:
+  kustomize:
+    dir: %s
+asserts:
+  - equal:
+      path: spec.replicas
+      value: 3
+    template: templates/deployment.yaml
+  - equal:
+      path: metadata.labels.environment
+      value: prod
+    template: templates/service.yaml
+  - notExists:
+      path: metadata.annotations["helm-unittest.io/file"]
+    template: templates/service.yaml
+  - isKind:
+      of: ConfigMap
+    template: kustomization.yaml
+`, dir))
+
+	assert.NoError(t, testResult.ExecError)
+	assert.True(t, testResult.Passed, testResult.Stringify())
+}
+
+func TestV3RunJobWithKustomizePostRendererResource(t *testing.T) {
+	dir := writeKustomization(t, `
+resources:
+  - rendered.yaml
+namePrefix: prod-
+`)
+
+	testResult := runJobWithPostRenderer(t, fmt.Sprintf(`
+it: should include the rendered manifests as the resource
+template: templates/deployment.yaml
+postRenderer:
+  kustomize:
+    dir: %s
+    resource: rendered.yaml
+asserts:
+  - matchRegex:
+      path: metadata.name
+      pattern: ^prod-
+`, dir))
+
+	assert.NoError(t, testResult.ExecError)
+	assert.True(t, testResult.Passed, testResult.Stringify())
+}
+
+func TestV3RunJobWithKustomizePostRendererErrors(t *testing.T) {
+	tests := []struct {
+		name         string
+		postRenderer string
+		expectedErr  string
+	}{
+		{
+			name:         "without dir",
+			postRenderer: "{kustomize: {}}",
+			expectedErr:  "kustomize post-renderer requires a dir",
+		},
+		{
+			name:         "with cmd",
+			postRenderer: "{cmd: cat, kustomize: {dir: overlays/prod}}",
+			expectedErr:  "post-renderer should either have a cmd or kustomize",
+		},
+		{
+			name:         "with timeout",
+			postRenderer: "{timeout: 10s, kustomize: {dir: overlays/prod}}",
+			expectedErr:  "kustomize post-renderer does not support timeout, env or workingDir",
+		},
+		{
+			name:         "with env",
+			postRenderer: "{env: {KIND: Rollout}, kustomize: {dir: overlays/prod}}",
+			expectedErr:  "kustomize post-renderer does not support timeout, env or workingDir",
+		},
+		{
+			name:         "with workingDir",
+			postRenderer: "{workingDir: overlays, kustomize: {dir: overlays/prod}}",
+			expectedErr:  "kustomize post-renderer does not support timeout, env or workingDir",
+		},
+		{
+			name:         "with missing dir",
+			postRenderer: "{kustomize: {dir: overlays/missing}}",
+			expectedErr:  "unable to find kustomization at overlays/missing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testResult := runJobWithPostRenderer(t, fmt.Sprintf(`
+it: should fail on the invalid kustomize post-renderer
+postRenderer: %s
+asserts:
+  - hasDocuments:
+      count: 1
+`, tt.postRenderer))
+
+			assert.ErrorContains(t, testResult.ExecError, tt.expectedErr)
+		})
+	}
+}
+
+func TestV3RunJobWithChainedPostRenderersAndStages(t *testing.T) {
+	toRollout := writePostRenderer(t, "#!/bin/sh\nsed \"s/^kind: Deployment/kind: Rollout/\"\n")
+	dir := writeKustomization(t, `
+resources:
+  - all.yaml
+namePrefix: prod-
+`)
+
+	testResult := runJobWithPostRenderer(t, fmt.Sprintf(`
+it: should assert each stage of the post-renderers
+template: templates/deployment.yaml
+documentIndex: 0
+postRenderers:
+  - name: rollout
+    cmd: %s
+  - name: overlay
+    kustomize:
+      dir: %s
+asserts:
+  - isKind:
+      of: Deployment
+    stage: rendered
+  - equal:
+      path: metadata.name
+      value: RELEASE-NAME-basic
+    stage: rendered
+  - isKind:
+      of: Rollout
+    stage: rollout
+  - equal:
+      path: metadata.name
+      value: RELEASE-NAME-basic
+    stage: rollout
+  - equal:
+      path: metadata.name
+      value: prod-RELEASE-NAME-basic
+    stage: overlay
+  - isKind:
+      of: Rollout
+    stage: postRendered
+  - equal:
+      path: metadata.name
+      value: prod-RELEASE-NAME-basic
+`, toRollout, dir))
+
+	assert.NoError(t, testResult.ExecError)
+	assert.True(t, testResult.Passed, testResult.Stringify())
+}
+
+func TestV3RunSuiteWithPostRendererAndRenderedStage(t *testing.T) {
+	postRenderer := writePostRenderer(t, "#!/bin/sh\nsed \"s/^kind: Deployment/kind: Rollout/\"\n")
+	suiteDoc := fmt.Sprintf(`
+suite: test suite with a post-renderer
+templates:
+  - templates/configmap.yaml
+  - templates/deployment.yaml
+postRenderer:
+  cmd: %s
+tests:
+  - it: should assert before and after post-rendering
+    template: templates/deployment.yaml
+    documentIndex: 0
+    asserts:
+      - isKind:
+          of: Deployment
+        stage: rendered
+      - isKind:
+          of: Rollout
+`, postRenderer)
+	testSuite := TestSuite{}
+	common.YmlUnmarshalTestHelper(suiteDoc, &testSuite, t)
+
+	c, _ := loader.Load(testV3BasicChart)
+	suiteResult := testSuite.RunV3(c, &snapshot.Cache{}, true, "", &results.TestSuiteResult{})
+
+	assert.True(t, suiteResult.Passed)
+	assert.Len(t, suiteResult.TestsResult, 1)
+	assert.NoError(t, suiteResult.TestsResult[0].ExecError)
+}
+
+func TestV3RunJobWithInvalidPostRendererChain(t *testing.T) {
+	tests := []struct {
+		name          string
+		postRenderers string
+		stage         string
+		expectedErr   string
+	}{
+		{
+			name:          "with unknown stage",
+			postRenderers: "[{name: first, cmd: cat}]",
+			stage:         "second",
+			expectedErr:   "assertion stage `second` is not defined, expected rendered, postRendered or the name of a post-renderer",
+		},
+		{
+			name:          "with duplicate names",
+			postRenderers: "[{name: first, cmd: cat}, {name: first, cmd: cat}]",
+			stage:         "first",
+			expectedErr:   "post-renderer name `first` is not unique",
+		},
+		{
+			name:          "with reserved name",
+			postRenderers: "[{name: rendered, cmd: cat}]",
+			stage:         "rendered",
+			expectedErr:   "post-renderer name `rendered` is reserved for the assertion stage",
+		},
+		{
+			name:          "without cmd",
+			postRenderers: "[{name: first, cmd: cat}, {name: second}]",
+			stage:         "first",
+			expectedErr:   "post-renderer 2 should have a cmd or kustomize",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testResult := runJobWithPostRenderer(t, fmt.Sprintf(`
+it: should fail on the invalid post-renderers
+postRenderers: %s
+asserts:
+  - hasDocuments:
+      count: 1
+    stage: %s
+`, tt.postRenderers, tt.stage))
+
+			assert.EqualError(t, testResult.ExecError, tt.expectedErr)
+		})
+	}
+}
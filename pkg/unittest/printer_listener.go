@@ -0,0 +1,211 @@
+package unittest
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/helm-unittest/helm-unittest/pkg/unittest/printer"
+	"github.com/helm-unittest/helm-unittest/pkg/unittest/results"
+	log "github.com/sirupsen/logrus"
+)
+
+// testUnitCounting stores counting numbers of test unit status
+type testUnitCounting struct {
+	passed  uint
+	failed  uint
+	errored uint
+	skipped uint
+	xfailed uint
+}
+
+// sprint returns string of counting result
+func (counting testUnitCounting) sprint(printer *printer.Printer) string {
+	var failedLabel string
+	if counting.failed > 0 {
+		failedLabel = printer.Danger("%d failed, ", counting.failed)
+	}
+	var erroredLabel string
+	if counting.errored > 0 {
+		erroredLabel = fmt.Sprintf("%d errored, ", counting.errored)
+	}
+	result := failedLabel + erroredLabel + fmt.Sprintf("%d passed, ", counting.passed)
+	if counting.skipped > 0 {
+		result += fmt.Sprintf("%d skipped, ", counting.skipped)
+	}
+	if counting.xfailed > 0 {
+		result += printer.Warning("%d xfailed, ", counting.xfailed)
+	}
+	result += fmt.Sprintf("%d total", counting.passed+counting.failed+counting.skipped+counting.xfailed)
+	return result
+}
+
+// testUnitCountingWithSnapshotFailed store testUnitCounting with snapshotFailed field
+type testUnitCountingWithSnapshotFailed struct {
+	testUnitCounting
+	snapshotFailed uint
+}
+
+// totalSnapshotCounting store testUnitCounting with snapshotFailed field
+type totalSnapshotCounting struct {
+	testUnitCounting
+	created  uint
+	vanished uint
+}
+
+// printerListener prints the progress and the summary of the test run to the console.
+type printerListener struct {
+	BaseRunListener
+	printer          *printer.Printer
+	failFast         bool
+	suiteCounting    testUnitCountingWithSnapshotFailed
+	testCounting     testUnitCounting
+	chartCounting    testUnitCounting
+	snapshotCounting totalSnapshotCounting
+}
+
+// newPrinterListener create a printerListener printing with the printer
+func newPrinterListener(printer *printer.Printer, failFast bool) *printerListener {
+	return &printerListener{printer: printer, failFast: failFast}
+}
+
+// OnChartStart print header before suite result of a chart
+func (l *printerListener) OnChartStart(chartName, chartPath string) {
+	l.printChartHeader(chartName, chartPath)
+}
+
+// OnChartFinished print the error of the chart and count chart status
+func (l *printerListener) OnChartFinished(_ string, passed bool, err error) {
+	if err != nil {
+		printErroredHeader(l.printer, err)
+	}
+	l.countChart(passed, err)
+}
+
+// OnRunFinished print the snapshot summary and the summary footer
+func (l *printerListener) OnRunFinished(_ bool, elapsed time.Duration) {
+	l.printSnapshotSummary()
+	l.printSummary(elapsed)
+}
+
+// OnSuiteFinished print suite result and count suites and tests status
+func (l *printerListener) OnSuiteFinished(result *results.TestSuiteResult) {
+	result.Print(l.printer, 0)
+	l.countSuite(result)
+	for _, testsResult := range result.TestsResult {
+		if testsResult == nil {
+			if l.failFast {
+				log.WithField("test-runner", "handle-suite-result").Debug("--failfast skip test")
+			}
+			continue
+		}
+		l.countTest(testsResult)
+	}
+}
+
+// printSummary print summary footer
+func (l *printerListener) printSummary(elapsed time.Duration) {
+	summaryFormat := `
+Charts:      %s
+Test Suites: %s
+Tests:       %s
+Snapshot:    %s
+Time:        %s
+`
+	l.printer.Println(
+		fmt.Sprintf(
+			summaryFormat,
+			l.chartCounting.sprint(l.printer),
+			l.suiteCounting.sprint(l.printer),
+			l.testCounting.sprint(l.printer),
+			l.snapshotCounting.sprint(l.printer),
+			elapsed.String(),
+		),
+		0,
+	)
+
+}
+
+// printChartHeader print header before suite result of a chart
+func (l *printerListener) printChartHeader(chartName, path string) {
+	headerFormat := `
+### Chart [ %s ] %s
+`
+	header := fmt.Sprintf(
+		headerFormat,
+		l.printer.Highlight("%s", chartName),
+		l.printer.Faint("%s", path),
+	)
+	l.printer.Println(header, 0)
+}
+
+// printErroredHeader if chart has exexution error print header with error
+func printErroredHeader(printer *printer.Printer, err error) {
+	headerFormat := `
+### ` + printer.Danger("%s", "Error: ") + ` %s
+`
+	header := fmt.Sprintf(headerFormat, err)
+	printer.Println(header, 0)
+}
+
+// printSnapshotSummary print snapshot summary in footer
+func (l *printerListener) printSnapshotSummary() {
+	if l.snapshotCounting.failed > 0 {
+		snapshotFormat := `
+Snapshot Summary: %s`
+
+		summary := l.printer.Danger("%d snapshot failed", l.snapshotCounting.failed) +
+			fmt.Sprintf(" in %d test suite.", l.suiteCounting.snapshotFailed) +
+			l.printer.Faint("%s", " Check changes and use `-u` to update snapshot.")
+
+		l.printer.Println(fmt.Sprintf(snapshotFormat, summary), 0)
+	}
+}
+
+// countSuite count suite status and snapshot status
+func (l *printerListener) countSuite(suite *results.TestSuiteResult) {
+	if suite.Skipped {
+		l.suiteCounting.skipped++
+	} else if suite.Passed {
+		l.suiteCounting.passed++
+	} else {
+		l.suiteCounting.failed++
+		if suite.ExecError != nil {
+			l.suiteCounting.errored++
+		}
+		if suite.SnapshotCounting.Failed > 0 {
+			l.suiteCounting.snapshotFailed++
+		}
+	}
+	l.snapshotCounting.failed += suite.SnapshotCounting.Failed
+	l.snapshotCounting.passed += suite.SnapshotCounting.Total - suite.SnapshotCounting.Failed
+	l.snapshotCounting.created += suite.SnapshotCounting.Created
+	l.snapshotCounting.vanished += suite.SnapshotCounting.Vanished
+}
+
+// countTest count test status
+func (l *printerListener) countTest(test *results.TestJobResult) {
+	if test.ExpectedFailure {
+		l.testCounting.xfailed++
+	} else if test.Passed {
+		l.testCounting.passed++
+	} else if test.Skipped {
+		l.testCounting.skipped++
+	} else {
+		l.testCounting.failed++
+		if test.ExecError != nil {
+			l.testCounting.errored++
+		}
+	}
+}
+
+// countChart count chart status
+func (l *printerListener) countChart(passed bool, err error) {
+	if passed {
+		l.chartCounting.passed++
+	} else {
+		l.chartCounting.failed++
+		if err != nil {
+			l.chartCounting.errored++
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package unittest
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/releaseutil"
+)
+
+// flattenTemplatesResult returns all rendered documents, ordered by template file name
+// and the position of the document within the template.
+func flattenTemplatesResult(templatesResult map[string][]common.K8sManifest) []common.K8sManifest {
+	templates := make([]string, 0, len(templatesResult))
+	for template := range templatesResult {
+		templates = append(templates, template)
+	}
+	sort.Strings(templates)
+
+	manifests := make([]common.K8sManifest, 0)
+	for _, template := range templates {
+		manifests = append(manifests, templatesResult[template]...)
+	}
+	return manifests
+}
+
+// isCrdsTemplate checks if the template is located in the crds folder of a chart.
+func isCrdsTemplate(template string) bool {
+	for _, part := range strings.Split(template, "/") {
+		if part == crdsPrefix {
+			return true
+		}
+	}
+	return false
+}
+
+// installOrderedManifests returns all rendered documents of the release in the order Helm would install them.
+// Documents of the crds folder are installed first, the other documents are sorted by template file name
+// and afterwards stable sorted by kind, using the install order of Helm.
+// Helm hooks are sorted by weight and kind, the pre-install and pre-upgrade hooks are placed before the documents,
+// the post-install and post-upgrade hooks after the documents, followed by the other hooks (like test hooks).
+// Raw documents (like NOTES.txt) are never installed, so they are omitted.
+func installOrderedManifests(templatesResult map[string][]common.K8sManifest) []common.K8sManifest {
+	crdsResult := make(map[string][]common.K8sManifest)
+	templateResult := make(map[string][]common.K8sManifest)
+	for template, manifests := range templatesResult {
+		if isCrdsTemplate(template) {
+			crdsResult[template] = manifests
+		} else {
+			templateResult[template] = manifests
+		}
+	}
+
+	preHooks := make([]common.K8sManifest, 0)
+	manifests := make([]common.K8sManifest, 0)
+	postHooks := make([]common.K8sManifest, 0)
+	otherHooks := make([]common.K8sManifest, 0)
+	for _, manifest := range flattenTemplatesResult(templateResult) {
+		if _, isRaw := manifest[common.RAW]; isRaw {
+			continue
+		}
+
+		switch {
+		case !common.IsHook(manifest):
+			manifests = append(manifests, manifest)
+		case hasHookEvent(manifest, release.HookPreInstall, release.HookPreUpgrade):
+			preHooks = append(preHooks, manifest)
+		case hasHookEvent(manifest, release.HookPostInstall, release.HookPostUpgrade):
+			postHooks = append(postHooks, manifest)
+		default:
+			otherHooks = append(otherHooks, manifest)
+		}
+	}
+	sortManifestsByKind(manifests, releaseutil.InstallOrder)
+	sortHooks(preHooks)
+	sortHooks(postHooks)
+	sortHooks(otherHooks)
+
+	ordered := flattenTemplatesResult(crdsResult)
+	ordered = append(ordered, preHooks...)
+	ordered = append(ordered, manifests...)
+	ordered = append(ordered, postHooks...)
+	return append(ordered, otherHooks...)
+}
+
+// hasHookEvent checks if the hook is defined for one of the events.
+func hasHookEvent(manifest common.K8sManifest, events ...release.HookEvent) bool {
+	for _, event := range common.HookEvents(manifest) {
+		if slices.Contains(events, release.HookEvent(event)) {
+			return true
+		}
+	}
+	return false
+}
+
+// sortHooks sorts the hooks by weight and kind, the same as Helm does.
+func sortHooks(hooks []common.K8sManifest) {
+	sortManifestsByKind(hooks, releaseutil.InstallOrder)
+	sort.SliceStable(hooks, func(i, j int) bool {
+		return common.HookWeight(hooks[i]) < common.HookWeight(hooks[j])
+	})
+}
+
+// sortManifestsByKind sorts the manifests by kind, according to the ordering.
+// Unknown kinds are placed after the known kinds and are sorted alphabetically, the same as Helm does.
+func sortManifestsByKind(manifests []common.K8sManifest, ordering releaseutil.KindSortOrder) {
+	kindOrder := make(map[string]int, len(ordering))
+	for idx, kind := range ordering {
+		kindOrder[kind] = idx
+	}
+
+	sort.SliceStable(manifests, func(i, j int) bool {
+		kindA, _ := manifests[i]["kind"].(string)
+		kindB, _ := manifests[j]["kind"].(string)
+		first, aok := kindOrder[kindA]
+		second, bok := kindOrder[kindB]
+
+		switch {
+		case !aok && !bok:
+			return kindA < kindB
+		case !aok:
+			return false
+		case !bok:
+			return true
+		default:
+			return first < second
+		}
+	})
+}
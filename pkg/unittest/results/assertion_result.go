@@ -17,21 +17,35 @@ type AssertionResult struct {
 	AssertType string
 	Not        bool
 	CustomInfo string
+	// Description explains why the assertion exists, shown next to the title
+	Description string
+	// ExpectedFailure is set when the assertion failed as expected (XFAIL), the assertion is passed
+	ExpectedFailure bool
+	// UnexpectedPass is set when the assertion passed while expected to fail (XPASS), the assertion is failed
+	UnexpectedPass bool
+	// ExpectFailureReason the reason the assertion is expected to fail
+	ExpectFailureReason string
 }
 
 func (ar AssertionResult) print(printer *printer.Printer, verbosity int) {
-	if ar.Passed {
+	if ar.Passed && !ar.ExpectedFailure {
 		return
 	}
 
-	printer.Println(printer.Danger("%s", ar.getTitle()), 2)
+	if ar.ExpectedFailure {
+		printer.Println(printer.Warning("%s", ar.Title()), 2)
+	} else {
+		printer.Println(printer.Danger("%s", ar.Title()), 2)
+	}
 	for _, infoLine := range ar.FailInfo {
 		printer.Println(infoLine, 3)
 	}
 	printer.Println("", 0)
 }
 
-func (ar AssertionResult) getTitle() string {
+// Title returns the custom info or else the index and type of the failed assertion, marked XFAIL or XPASS
+// when failed as expected or passed unexpectedly, followed by the description of the assertion when set.
+func (ar AssertionResult) Title() string {
 	var title string
 
 	if ar.CustomInfo != "" {
@@ -41,7 +55,16 @@ func (ar AssertionResult) getTitle() string {
 		if ar.Not {
 			notAnnotation = " NOT"
 		}
-		title = fmt.Sprintf("- asserts[%d]%s `%s` fail", ar.Index, notAnnotation, ar.AssertType)
+		status := "fail"
+		if ar.ExpectedFailure {
+			status = "XFAIL"
+		} else if ar.UnexpectedPass {
+			status = "XPASS"
+		}
+		title = fmt.Sprintf("- asserts[%d]%s `%s` %s", ar.Index, notAnnotation, ar.AssertType, status)
+	}
+	if ar.Description != "" {
+		title = fmt.Sprintf("%s: %s", title, ar.Description)
 	}
 	return title
 }
@@ -49,7 +72,7 @@ func (ar AssertionResult) getTitle() string {
 // ToString writing the object to a customized formatted string.
 func (ar AssertionResult) stringify() string {
 	var content strings.Builder
-	fmt.Fprintf(&content, "\t\t %s \n", ar.getTitle())
+	fmt.Fprintf(&content, "\t\t %s \n", ar.Title())
 
 	if !ar.Skipped {
 		for _, infoLine := range ar.FailInfo {
@@ -11,17 +11,24 @@ import (
 // TestJobResult result return by TestJob.Run
 type TestJobResult struct {
 	DisplayName   string
+	Description   string
 	Index         int
 	Passed        bool
 	Skipped       bool
 	ExecError     error
 	AssertsResult []*AssertionResult
 	Duration      time.Duration
+	// ExpectedFailure is set when the test job, or assertions of it, failed as expected (XFAIL), the test job is passed
+	ExpectedFailure bool
+	// UnexpectedPass is set when the test job passed while expected to fail (XPASS), the test job is failed
+	UnexpectedPass bool
+	// ExpectFailureReason the reason the test job is expected to fail
+	ExpectFailureReason string
 }
 
 // print the information to the console.
 func (tjr TestJobResult) print(printer *printer.Printer, verbosity int) {
-	if tjr.Passed {
+	if tjr.Passed && !tjr.ExpectedFailure {
 		return
 	}
 
@@ -33,18 +40,114 @@ func (tjr TestJobResult) print(printer *printer.Printer, verbosity int) {
 		return
 	}
 
+	if tjr.ExpectedFailure {
+		msg := printer.Highlight("- ")
+		msg += printer.WarningLabel("XFAIL")
+		msg += printer.Warning(" '%s'", tjr.DisplayName)
+		if reasons := tjr.expectFailureReasons(); len(reasons) > 0 {
+			msg += printer.Warning(": %s", strings.Join(reasons, "; "))
+		}
+		printer.Println(msg, 1)
+		return
+	}
+
+	if tjr.UnexpectedPass {
+		msg := printer.Highlight("- ")
+		msg += printer.DangerLabel("XPASS")
+		msg += printer.Danger(" '%s'", tjr.title())
+		printer.Println(msg, 1)
+		printer.Println(printer.Danger("%s\n", tjr.unexpectedPassInfo()), 2)
+		return
+	}
+
 	if tjr.ExecError != nil {
-		printer.Println(printer.Highlight("- %s", tjr.DisplayName), 1)
+		printer.Println(printer.Highlight("- %s", tjr.title()), 1)
 		printer.Println(printer.Highlight("Error: %s\n", tjr.ExecError.Error()), 2)
 		return
 	}
 
-	printer.Println(printer.Danger("- %s\n", tjr.DisplayName), 1)
+	printer.Println(printer.Danger("- %s\n", tjr.title()), 1)
 	for _, assertResult := range tjr.AssertsResult {
 		assertResult.print(printer, verbosity)
 	}
 }
 
+// title returns the display name, followed by the description of the test job when set.
+func (tjr TestJobResult) title() string {
+	if tjr.Description == "" {
+		return tjr.DisplayName
+	}
+	return fmt.Sprintf("%s: %s", tjr.DisplayName, tjr.Description)
+}
+
+// FailureMessage returns the message of a failed test job for the test output files,
+// with the descriptions of the test job and its failed assertions when set.
+// Test jobs or assertions which passed unexpectedly are labeled XPASS, with the reasons they were expected to fail.
+func (tjr TestJobResult) FailureMessage() string {
+	label := "Failed"
+	var details []string
+	if tjr.UnexpectedPass {
+		label = "XPASS"
+		details = appendNotEmpty(details, tjr.ExpectFailureReason)
+	}
+	details = appendNotEmpty(details, tjr.Description)
+	for _, assertResult := range tjr.AssertsResult {
+		if assertResult == nil || assertResult.Passed || assertResult.Skipped {
+			continue
+		}
+		if assertResult.UnexpectedPass {
+			label = "XPASS"
+			details = appendNotEmpty(details, assertResult.ExpectFailureReason)
+		}
+		details = appendNotEmpty(details, assertResult.Description)
+	}
+
+	if len(details) == 0 {
+		return label
+	}
+	return fmt.Sprintf("%s: %s", label, strings.Join(details, "; "))
+}
+
+// ExpectedFailureMessage returns the message of a test job which failed as expected for the test output files,
+// with the reasons the test job and its assertions were expected to fail.
+func (tjr TestJobResult) ExpectedFailureMessage() string {
+	reasons := tjr.expectFailureReasons()
+	if len(reasons) == 0 {
+		return "XFAIL"
+	}
+	return fmt.Sprintf("XFAIL: %s", strings.Join(reasons, "; "))
+}
+
+// expectFailureReasons returns the reasons the test job and its assertions which failed as expected were expected to fail.
+func (tjr TestJobResult) expectFailureReasons() []string {
+	var reasons []string
+	if tjr.ExpectedFailure {
+		reasons = appendNotEmpty(reasons, tjr.ExpectFailureReason)
+	}
+	for _, assertResult := range tjr.AssertsResult {
+		if assertResult != nil && assertResult.ExpectedFailure {
+			reasons = appendNotEmpty(reasons, assertResult.ExpectFailureReason)
+		}
+	}
+	return reasons
+}
+
+// unexpectedPassInfo returns the information of a test job which passed while expected to fail.
+func (tjr TestJobResult) unexpectedPassInfo() string {
+	if tjr.ExpectFailureReason == "" {
+		return "Expected to fail, but passed"
+	}
+	return fmt.Sprintf("Expected to fail, but passed: %s", tjr.ExpectFailureReason)
+}
+
+// appendNotEmpty appends the value to the values, unless the value is empty.
+func appendNotEmpty(values []string, value string) []string {
+	if value == "" {
+		return values
+	}
+	return append(values, value)
+}
+
 // Stringify writing the object to a customized formatted string.
 func (tjr TestJobResult) Stringify() string {
 	var content strings.Builder
@@ -53,6 +156,14 @@ func (tjr TestJobResult) Stringify() string {
 		fmt.Fprintf(&content, "SKIPPED '%s' \n", tjr.DisplayName)
 	}
 
+	if tjr.ExpectedFailure {
+		fmt.Fprintf(&content, "%s \n", tjr.ExpectedFailureMessage())
+	}
+
+	if tjr.UnexpectedPass {
+		fmt.Fprintf(&content, "XPASS '%s' %s \n", tjr.DisplayName, tjr.unexpectedPassInfo())
+	}
+
 	if tjr.ExecError != nil {
 		fmt.Fprintf(&content, "%s\n", tjr.ExecError.Error())
 	}
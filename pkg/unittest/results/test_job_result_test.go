@@ -86,3 +86,96 @@ func TestStringify_WithExecErrorAndAssertions(t *testing.T) {
 	result := tjr.Stringify()
 	assert.Equal(t, expected, result)
 }
+
+func TestStringify_WithAssertionDescription(t *testing.T) {
+	tjr := TestJobResult{
+		AssertsResult: []*AssertionResult{
+			{AssertType: "isSubset", FailInfo: []string{"assertion error"}, Description: "PCI requires readOnlyRootFilesystem"},
+		},
+	}
+	expected := "\t\t - asserts[0] `isSubset` fail: PCI requires readOnlyRootFilesystem \n\t\t\t assertion error \n"
+	assert.Equal(t, expected, tjr.Stringify())
+}
+
+// test FailureMessage
+func TestFailureMessage_WithoutDescriptions(t *testing.T) {
+	tjr := TestJobResult{
+		AssertsResult: []*AssertionResult{{FailInfo: []string{"assertion error"}}},
+	}
+	assert.Equal(t, "Failed", tjr.FailureMessage())
+}
+
+func TestFailureMessage_WithDescriptions(t *testing.T) {
+	tjr := TestJobResult{
+		Description: "hardened pods",
+		AssertsResult: []*AssertionResult{
+			{Passed: true, Description: "passed assertion"},
+			{Description: "PCI requires readOnlyRootFilesystem"},
+			{Skipped: true, Description: "skipped assertion"},
+			{},
+			nil,
+		},
+	}
+	assert.Equal(t, "Failed: hardened pods; PCI requires readOnlyRootFilesystem", tjr.FailureMessage())
+}
+
+// test expected failures
+func TestExpectedFailureJob_PrintsXFailWithReasons(t *testing.T) {
+	flag := false
+	pr := printer.NewPrinter(new(bytes.Buffer), &flag)
+
+	tjr := TestJobResult{
+		DisplayName:         "some job",
+		Passed:              true,
+		ExpectedFailure:     true,
+		ExpectFailureReason: "known bug",
+		AssertsResult: []*AssertionResult{
+			{Passed: true, ExpectedFailure: true, ExpectFailureReason: "another known bug"},
+		},
+	}
+
+	tjr.print(pr, 1)
+	assert.Contains(t, fmt.Sprintf("%s", pr.Writer), "- XFAIL 'some job': known bug; another known bug")
+	assert.Equal(t, "XFAIL: known bug; another known bug", tjr.ExpectedFailureMessage())
+}
+
+func TestUnexpectedPassJob_PrintsXPass(t *testing.T) {
+	flag := false
+	pr := printer.NewPrinter(new(bytes.Buffer), &flag)
+
+	tjr := TestJobResult{
+		DisplayName:    "some job",
+		UnexpectedPass: true,
+	}
+
+	tjr.print(pr, 1)
+	assert.Contains(t, fmt.Sprintf("%s", pr.Writer), "- XPASS 'some job'")
+	assert.Contains(t, fmt.Sprintf("%s", pr.Writer), "Expected to fail, but passed")
+	assert.Equal(t, "XPASS", tjr.FailureMessage())
+}
+
+func TestFailureMessage_WithUnexpectedPasses(t *testing.T) {
+	tjr := TestJobResult{
+		AssertsResult: []*AssertionResult{
+			{UnexpectedPass: true, ExpectFailureReason: "known bug", Description: "names are unique"},
+			{Passed: true, ExpectedFailure: true, ExpectFailureReason: "another known bug"},
+		},
+	}
+	assert.Equal(t, "XPASS: known bug; names are unique", tjr.FailureMessage())
+	assert.Equal(t, "XFAIL: another known bug", tjr.ExpectedFailureMessage())
+}
+
+func TestStringify_WithExpectedFailure(t *testing.T) {
+	tjr := TestJobResult{
+		DisplayName:         "some job",
+		Passed:              true,
+		ExpectedFailure:     true,
+		ExpectFailureReason: "known bug",
+		AssertsResult: []*AssertionResult{
+			{AssertType: "equal", FailInfo: []string{"assertion error"}},
+		},
+	}
+	expected := "XFAIL: known bug \n"
+	expected += "\t\t - asserts[0] `equal` fail \n\t\t\t assertion error \n"
+	assert.Equal(t, expected, tjr.Stringify())
+}
@@ -223,3 +223,17 @@ func TestTestSuiteResultPrint_TestSkipped(t *testing.T) {
 	assert.NotContains(t, buffer.String(), "SKIP  this-test-suite")
 	assert.Contains(t, buffer.String(), "- SKIPPED 'second-skip-test'")
 }
+
+func TestTestSuiteResultPrintFailedTestAsssertionWithDescriptions(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	testPrinter := printer.NewPrinter(buffer, nil)
+	given := createTestSuiteResult("A Test Suite", "A Test Case", "filePath", "", nil, nil, []string{"Error1"}, false, false)
+	given.TestsResult[0].Description = "hardened pods"
+	given.TestsResult[0].AssertsResult[0].Description = "PCI requires readOnlyRootFilesystem"
+
+	expectedResult := fmt.Sprintf(" FAIL  %s\t./%s\n\t- %s: hardened pods\n\n\t\t- asserts[0] `equal` fail: PCI requires readOnlyRootFilesystem\n\t\t\t%s\n\n",
+		given.DisplayName, given.FilePath, given.TestsResult[0].DisplayName, given.TestsResult[0].AssertsResult[0].FailInfo[0])
+	given.Print(testPrinter, 0)
+
+	assert.Equal(t, expectedResult, buffer.String())
+}
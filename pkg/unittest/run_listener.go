@@ -0,0 +1,83 @@
+package unittest
+
+import (
+	"time"
+
+	"github.com/helm-unittest/helm-unittest/pkg/unittest/results"
+	"github.com/helm-unittest/helm-unittest/pkg/unittest/snapshot"
+)
+
+// RunListener listens to the events of a test run of the TestRunner, like to report the progress.
+// The console output and the test output files of the TestRunner are listeners as well.
+type RunListener interface {
+	// OnChartStart is called before the test suites of the chart run.
+	OnChartStart(chartName, chartPath string)
+	// OnChartFinished is called after the test suites of the chart ran,
+	// err is set when the chart or its test suites can not be loaded.
+	OnChartFinished(chartPath string, passed bool, err error)
+	// OnSuiteStart is called before the test jobs of the test suite run.
+	OnSuiteStart(suite *TestSuite)
+	// OnSuiteFinished is called after the test jobs of the test suite ran, or the test suite failed
+	// to be parsed or its snapshots failed to be stored, with the error as ExecError of the result.
+	OnSuiteFinished(result *results.TestSuiteResult)
+	// OnJobFinished is called after the test job ran, or is skipped.
+	OnJobFinished(suite *TestSuite, result *results.TestJobResult)
+	// OnAssertion is called for each assertion of the test job, before OnJobFinished of the test job.
+	OnAssertion(suite *TestSuite, job *results.TestJobResult, result *results.AssertionResult)
+	// OnSnapshotChanged is called after the changed snapshots of the test suite are stored.
+	OnSnapshotChanged(suite *TestSuite, cache *snapshot.Cache)
+	// OnRunFinished is called after all charts ran.
+	OnRunFinished(passed bool, elapsed time.Duration)
+}
+
+// BaseRunListener implements RunListener without handling any event,
+// listeners embed it to handle only the events they are interested in.
+type BaseRunListener struct{}
+
+// OnChartStart implement RunListener
+func (BaseRunListener) OnChartStart(string, string) {}
+
+// OnChartFinished implement RunListener
+func (BaseRunListener) OnChartFinished(string, bool, error) {}
+
+// OnSuiteStart implement RunListener
+func (BaseRunListener) OnSuiteStart(*TestSuite) {}
+
+// OnSuiteFinished implement RunListener
+func (BaseRunListener) OnSuiteFinished(*results.TestSuiteResult) {}
+
+// OnJobFinished implement RunListener
+func (BaseRunListener) OnJobFinished(*TestSuite, *results.TestJobResult) {}
+
+// OnAssertion implement RunListener
+func (BaseRunListener) OnAssertion(*TestSuite, *results.TestJobResult, *results.AssertionResult) {}
+
+// OnSnapshotChanged implement RunListener
+func (BaseRunListener) OnSnapshotChanged(*TestSuite, *snapshot.Cache) {}
+
+// OnRunFinished implement RunListener
+func (BaseRunListener) OnRunFinished(bool, time.Duration) {}
+
+// runListeners the listeners subscribed to a test run, notified in order.
+type runListeners []RunListener
+
+// notify calls the event on every listener.
+func (l runListeners) notify(event func(listener RunListener)) {
+	for _, listener := range l {
+		event(listener)
+	}
+}
+
+// notifyJobFinished notifies the assertions of the test job, followed by the test job itself.
+func (l runListeners) notifyJobFinished(suite *TestSuite, result *results.TestJobResult) {
+	if len(l) == 0 || result == nil {
+		return
+	}
+	for _, assertResult := range result.AssertsResult {
+		if assertResult == nil {
+			continue
+		}
+		l.notify(func(listener RunListener) { listener.OnAssertion(suite, result, assertResult) })
+	}
+	l.notify(func(listener RunListener) { listener.OnJobFinished(suite, result) })
+}
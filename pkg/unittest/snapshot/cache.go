@@ -2,7 +2,9 @@ package snapshot
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"strings"
 
@@ -23,9 +25,11 @@ type CompareResult struct {
 
 // Cache manage snapshot caching
 type Cache struct {
-	Filepath      string
-	Existed       bool
-	IsUpdating    bool
+	Filepath   string
+	Existed    bool
+	IsUpdating bool
+	// Store reads and writes the snapshot file instead of the OS file system, when set
+	Store         Store
 	cached        map[string]map[uint]string
 	current       map[string]map[uint]string
 	updatedCount  uint
@@ -35,9 +39,9 @@ type Cache struct {
 
 // RestoreFromFile restore cached snapshot from cache file
 func (s *Cache) RestoreFromFile() error {
-	content, err := os.ReadFile(s.Filepath)
+	content, err := s.readFile()
 	if err != nil {
-		if os.IsNotExist(err) {
+		if errors.Is(err, fs.ErrNotExist) {
 			return nil
 		}
 		return err
@@ -50,6 +54,22 @@ func (s *Cache) RestoreFromFile() error {
 	return nil
 }
 
+// readFile reads the snapshot file from the Store, or else from the OS file system
+func (s *Cache) readFile() ([]byte, error) {
+	if s.Store != nil {
+		return s.Store.ReadFile(s.Filepath)
+	}
+	return os.ReadFile(s.Filepath)
+}
+
+// writeFile writes the snapshot file to the Store, or else to the OS file system
+func (s *Cache) writeFile(content []byte) error {
+	if s.Store != nil {
+		return s.Store.WriteFile(s.Filepath, content)
+	}
+	return os.WriteFile(s.Filepath, content, 0644)
+}
+
 func (s *Cache) getCached(test string, idx uint) (string, bool) {
 	if cachedByTest, ok := s.cached[test]; ok {
 		if cachedOfAssertion, ok := cachedByTest[idx]; ok {
@@ -169,7 +189,7 @@ func (s *Cache) StoreToFileIfNeeded() (bool, error) {
 			return false, err
 		}
 
-		if err := os.WriteFile(s.Filepath, byteBuffer.Bytes(), 0644); err != nil {
+		if err := s.writeFile(byteBuffer.Bytes()); err != nil {
 			return false, err
 		}
 
@@ -42,3 +42,18 @@ func ensureDir(path string) error {
 	}
 	return nil
 }
+
+// CreateSnapshotOfSuiteInStore retruns snapshot.Cache for suite file, which reads and writes
+// the snapshot file in the `__snapshot__` dir with the store instead of the OS file system
+func CreateSnapshotOfSuiteInStore(store Store, path string, isUpdating bool) (*Cache, error) {
+	cache := &Cache{
+		Filepath:   filepath.Join(filepath.Dir(path), snapshotDirName, filepath.Base(path)+snapshotFileExt),
+		IsUpdating: isUpdating,
+		Store:      store,
+	}
+
+	if err := cache.RestoreFromFile(); err != nil {
+		return nil, err
+	}
+	return cache, nil
+}
@@ -0,0 +1,73 @@
+package snapshot
+
+import (
+	"io/fs"
+	"maps"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Store reads and writes the snapshot files, instead of the OS file system.
+type Store interface {
+	// ReadFile returns the content of the snapshot file, or an error matching fs.ErrNotExist when not existed.
+	ReadFile(name string) ([]byte, error)
+	// WriteFile stores the content of the snapshot file.
+	WriteFile(name string, content []byte) error
+}
+
+// MemoryStore stores the snapshot files in memory. Snapshot files which are not written
+// are read from FS when set, so existing snapshots of an fs.FS are compared.
+type MemoryStore struct {
+	FS    fs.FS
+	lock  sync.RWMutex
+	files map[string][]byte
+}
+
+// NewMemoryStore returns a MemoryStore reading the snapshot files not written from the fsys, which can be nil.
+func NewMemoryStore(fsys fs.FS) *MemoryStore {
+	return &MemoryStore{FS: fsys, files: map[string][]byte{}}
+}
+
+// ReadFile implement Store
+func (s *MemoryStore) ReadFile(name string) ([]byte, error) {
+	name = storePath(name)
+
+	s.lock.RLock()
+	content, ok := s.files[name]
+	s.lock.RUnlock()
+	if ok {
+		return content, nil
+	}
+
+	if s.FS == nil {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: fs.ErrNotExist}
+	}
+	return fs.ReadFile(s.FS, name)
+}
+
+// WriteFile implement Store
+func (s *MemoryStore) WriteFile(name string, content []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.files == nil {
+		s.files = map[string][]byte{}
+	}
+	s.files[storePath(name)] = append([]byte(nil), content...)
+	return nil
+}
+
+// Files returns the written snapshot files by their path, to persist them.
+func (s *MemoryStore) Files() map[string][]byte {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return maps.Clone(s.files)
+}
+
+// storePath returns the name as path of an fs.FS, slash separated and relative to its root.
+func storePath(name string) string {
+	return strings.TrimPrefix(path.Clean(filepath.ToSlash(name)), "/")
+}
@@ -0,0 +1,73 @@
+package snapshot_test
+
+import (
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	. "github.com/helm-unittest/helm-unittest/pkg/unittest/snapshot"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryStoreReadsFromFileSystemUntilWritten(t *testing.T) {
+	a := assert.New(t)
+	store := NewMemoryStore(fstest.MapFS{
+		"tests/__snapshot__/cache_test.yaml.snap": {Data: []byte(lastTimeContent)},
+	})
+
+	content, err := store.ReadFile("/tests/__snapshot__/cache_test.yaml.snap")
+	a.NoError(err)
+	a.Equal(lastTimeContent, string(content))
+	a.Empty(store.Files())
+
+	a.NoError(store.WriteFile("tests/__snapshot__/cache_test.yaml.snap", []byte("changed")))
+	content, err = store.ReadFile("tests/__snapshot__/cache_test.yaml.snap")
+	a.NoError(err)
+	a.Equal("changed", string(content))
+	a.Equal(map[string][]byte{"tests/__snapshot__/cache_test.yaml.snap": []byte("changed")}, store.Files())
+}
+
+func TestMemoryStoreWhenNotExisted(t *testing.T) {
+	_, err := NewMemoryStore(nil).ReadFile("cache_test.yaml.snap")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+
+	_, err = NewMemoryStore(fstest.MapFS{}).ReadFile("cache_test.yaml.snap")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+}
+
+func TestCacheWithStore(t *testing.T) {
+	a := assert.New(t)
+	store := NewMemoryStore(fstest.MapFS{
+		"tests/__snapshot__/cache_test.yaml.snap": {Data: []byte(lastTimeContent)},
+	})
+	cache, err := CreateSnapshotOfSuiteInStore(store, "tests/cache_test.yaml", false)
+	a.NoError(err)
+	verifyCache(a, cache, true, true, 0, 0, 0, 0, 2)
+
+	cache.Compare(cache_before, 1, content1)
+	cache.Compare("new test", 1, contentNew)
+	verifyCache(a, cache, true, true, 2, 1, 0, 0, 1)
+
+	stored, storeErr := cache.StoreToFileIfNeeded()
+	a.True(stored)
+	a.NoError(storeErr)
+
+	expectedCacheContent := `cached before:
+  1: |
+    a:
+      b: c
+new test:
+  1: |
+    x:
+      "y": z
+`
+	a.Equal(map[string][]byte{"tests/__snapshot__/cache_test.yaml.snap": []byte(expectedCacheContent)}, store.Files())
+}
+
+func TestCacheWithStoreWhenFirstTime(t *testing.T) {
+	a := assert.New(t)
+	cache, err := CreateSnapshotOfSuiteInStore(NewMemoryStore(nil), "tests/cache_test.yaml", true)
+	a.NoError(err)
+	a.True(cache.IsUpdating)
+	verifyCache(a, cache, false, false, 0, 0, 0, 0, 0)
+}
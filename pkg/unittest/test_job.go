@@ -3,6 +3,8 @@ package unittest
 import (
 	"bytes"
 	"cmp"
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -18,6 +20,7 @@ import (
 	"github.com/helm-unittest/helm-unittest/internal/common"
 	"github.com/helm-unittest/helm-unittest/pkg/unittest/results"
 	"github.com/helm-unittest/helm-unittest/pkg/unittest/snapshot"
+	"github.com/helm-unittest/helm-unittest/pkg/unittest/validators"
 	"github.com/helm-unittest/helm-unittest/pkg/unittest/valueutils"
 	log "github.com/sirupsen/logrus"
 
@@ -42,14 +45,36 @@ const regexPattern string = "(?msU)^(?:.+: |.+ \\()(?:(.+):\\d+:\\d+).+(?:.+>)*:
 const fileKeyPrefix = "#### file:"
 const yamlFileSeparator = "---\n" + fileKeyPrefix
 
+// Options to select the Helm hooks to assert.
+const (
+	hooksInclude = "include"
+	hooksExclude = "exclude"
+	hooksOnly    = "only"
+)
+
 var (
 	regexPostRenderPattern = regexp.MustCompile(fileKeyPrefix + ` (.*)`)
 	regexErrorPattern      = regexp.MustCompile(regexPattern)
 )
 
 type PostRendererConfig struct {
+	// Name of the step in a chain of post-renderers, to assert its output with the assertion stage
+	Name     string   `yaml:"name"`
 	Cmd      string   `yaml:"cmd"`
 	ArgSlice []string `yaml:"args"`
+	// Timeout cancels the post-renderer when it runs longer, like "30s"
+	Timeout string `yaml:"timeout"`
+	// Env are the environment variables added to the environment of the post-renderer
+	Env map[string]string `yaml:"env"`
+	// WorkingDir is the directory the post-renderer runs in, relative to the test suite file
+	WorkingDir string `yaml:"workingDir"`
+	// Kustomize applies a local kustomization in-process, instead of running the command
+	Kustomize *KustomizeConfig `yaml:"kustomize"`
+}
+
+// isSet returns whether a post-renderer is configured
+func (c PostRendererConfig) isSet() bool {
+	return c.Cmd != "" || c.Kustomize != nil
 }
 
 func spliteChartRoutes(routePath string) []string {
@@ -128,6 +153,51 @@ func parseYamlFile(rendered string) ([]common.K8sManifest, error) {
 	return parsedYamls, nil
 }
 
+// parseJsonFile parses the rendered JSON objects, converted to YAML so the values
+// have the same types as the values of YAML manifests. Other JSON values are kept as raw documents.
+func parseJsonFile(rendered string) ([]common.K8sManifest, error) {
+	decoder := json.NewDecoder(strings.NewReader(rendered))
+	parsedJsons := make([]common.K8sManifest, 0)
+
+	for {
+		var rawJson json.RawMessage
+		if err := decoder.Decode(&rawJson); err != nil {
+			if err == io.EOF {
+				break
+			} else {
+				return nil, err
+			}
+		}
+
+		var parsedJson any
+		if err := json.Unmarshal(rawJson, &parsedJson); err != nil {
+			return nil, err
+		}
+		// Only objects are manifests, other values like a list of dashboards are kept as raw text.
+		if _, isObject := parsedJson.(map[string]any); !isObject {
+			parsedJsons = append(parsedJsons, parseTextFile(string(rawJson))...)
+			continue
+		}
+
+		converted := new(bytes.Buffer)
+		encoder := common.YamlNewEncoder(converted)
+		if err := encoder.Encode(parsedJson); err != nil {
+			return nil, err
+		}
+		if err := encoder.Close(); err != nil {
+			return nil, err
+		}
+
+		manifests, err := parseYamlFile(converted.String())
+		if err != nil {
+			return nil, err
+		}
+		parsedJsons = append(parsedJsons, manifests...)
+	}
+
+	return parsedJsons, nil
+}
+
 func parseTextFile(rendered string) []common.K8sManifest {
 	manifests := make([]common.K8sManifest, 0)
 	manifest := make(common.K8sManifest)
@@ -180,6 +250,8 @@ type CapabilitiesFields map[string]any
 // TestJob definition of a test, including values and assertions
 type TestJob struct {
 	Name             string `yaml:"it"`
+	Description      string `yaml:"description"`
+	Message          string `yaml:"message"`
 	Values           []string
 	Set              map[string]any
 	Template         string
@@ -203,9 +275,18 @@ type TestJob struct {
 	Skip               struct {
 		Reason string `yaml:"reason"`
 	} `yaml:"skip"`
+	ExpectFailure      *ExpectFailure               `yaml:"expectFailure"`
 	KubernetesProvider KubernetesFakeClientProvider `yaml:"kubernetesProvider"`
 	PostRendererConfig PostRendererConfig           `yaml:"postRenderer"`
-
+	PostRenderers      []PostRendererConfig         `yaml:"postRenderers"`
+	Hooks              string                       `yaml:"hooks"`
+	Timeout            string                       `yaml:"timeout"`
+	RawExtensions      []string                     `yaml:"rawExtensions"`
+	DiffFrom           *DiffFrom                    `yaml:"diffFrom"`
+	Helper             *HelperConfig                `yaml:"helper"`
+
+	// release, chart and values the chart is rendered with
+	renderContext validators.RenderContext
 	// global set values
 	globalSet map[string]any
 	// route indicate which chart in the dependency hierarchy
@@ -238,21 +319,62 @@ func (t *TestJob) configOrDefault() TestConfig {
 	return t.config
 }
 
+// description returns the description of the test job, or else its message.
+func (t *TestJob) description() string {
+	return cmp.Or(t.Description, t.Message)
+}
+
 // RunV3 render the chart and validate it with assertions in TestJob.
 func (t *TestJob) RunV3(
 	result *results.TestJobResult,
+) *results.TestJobResult {
+	return t.ExpectFailure.applyToTestJob(t.runV3(result))
+}
+
+func (t *TestJob) runV3(
+	result *results.TestJobResult,
 ) *results.TestJobResult {
 	startTestRun := time.Now()
 	log.WithField(LOG_TEST_JOB, "run-v3").Debug("job name ", t.Name)
 	t.determineRenderSuccess()
 	result.DisplayName = t.Name
+	result.Description = t.description()
+
+	if t.Helper != nil {
+		if err := t.Helper.validate(); err != nil {
+			result.ExecError = err
+			return result
+		}
+		// Only the helper is rendered and asserted.
+		t.defaultTemplatesToAssert = []string{helperTemplateName}
+	}
+
 	userValues, err := t.getUserValues()
 	if err != nil {
 		result.ExecError = err
 		return result
 	}
 
-	outputOfFiles, renderSucceed, renderError := t.renderV3Chart([]byte(userValues))
+	ctx, cancel, err := t.timeoutContext()
+	if err != nil {
+		result.ExecError = err
+		return result
+	}
+	defer cancel()
+
+	// When defaultTemplatesToAssert is empty, ensure all templates will be validated.
+	if len(t.defaultTemplatesToAssert) == 0 {
+		// Set all files
+		t.defaultTemplatesToAssert = []string{multiWildcard}
+	}
+
+	baselineConfig := t.DiffFrom.baselineConfig(t)
+	outputOfFiles, renderContext, renderSucceed, renderError := t.renderV3ChartInTime(ctx, []byte(userValues))
+	if ctx.Err() != nil {
+		result.ExecError = t.timeoutError("rendering")
+		return result
+	}
+	t.renderContext = renderContext
 	writeError := writeRenderedOutput(t.configOrDefault().renderPath, outputOfFiles)
 	if writeError != nil {
 		result.ExecError = writeError
@@ -264,13 +386,33 @@ func (t *TestJob) RunV3(
 		// Continue to enable matching error via failedTemplate assert
 	}
 
-	postRenderedManifestsOfFiles, didPostRender, err := t.postRender(outputOfFiles)
+	postRenderedManifestsOfFiles, outputOfSteps, didPostRender, err := t.postRender(ctx, outputOfFiles)
+	if ctx.Err() != nil {
+		result.ExecError = t.timeoutError("post-rendering")
+		return result
+	}
 	if err != nil {
 		result.ExecError = err
 		return result
 	}
 
-	manifestsOfFiles, err := t.parseManifestsFromOutputOfFiles(postRenderedManifestsOfFiles, renderSucceed)
+	manifestsOfFiles, err := t.parseAndFilterManifests(postRenderedManifestsOfFiles, renderSucceed)
+	if err != nil {
+		result.ExecError = err
+		return result
+	}
+
+	manifestsOfStages, err := t.manifestsOfStages(outputOfFiles, outputOfSteps, renderSucceed)
+	if err != nil {
+		result.ExecError = err
+		return result
+	}
+
+	baselineManifests, err := t.DiffFrom.render(ctx, t, baselineConfig)
+	if ctx.Err() != nil {
+		result.ExecError = t.timeoutError("rendering the baseline")
+		return result
+	}
 	if err != nil {
 		result.ExecError = err
 		return result
@@ -287,6 +429,8 @@ func (t *TestJob) RunV3(
 
 	assertionsConfig := AssertionConfig{
 		templatesResult:        manifestsOfFiles,
+		stagesResult:           manifestsOfStages,
+		baselineResult:         baselineManifests,
 		snapshotComparer:       snapshotComparer,
 		renderSucceed:          renderSucceed,
 		failFast:               t.configOrDefault().failFast,
@@ -294,9 +438,18 @@ func (t *TestJob) RunV3(
 		renderError:            renderError,
 		isSkipEmptyTemplate:    t.configOrDefault().isSkipEmptyTemplate,
 		isSkipSchemaValidation: t.configOrDefault().isSkipSchemaValidation,
+		clusterObjects:         t.KubernetesProvider.Objects,
+		renderContext:          t.renderContext,
+		baseDir:                filepath.Dir(t.definitionFile),
+		fileSystem:             t.configOrDefault().fileSystem,
+		jobContext:             ctx,
 	}
 
 	result.Passed, result.AssertsResult = t.runAssertions(assertionsConfig)
+	if ctx.Err() != nil {
+		result.Passed = false
+		result.ExecError = t.timeoutError("asserting")
+	}
 
 	// When all assertions are skipped, we consider the test job as skipped.
 	if len(result.AssertsResult) > 0 {
@@ -322,13 +475,13 @@ func (t *TestJob) getUserValues() (string, error) {
 	for _, specifiedPath := range t.Values {
 		value := map[string]any{}
 		var valueFilePath string
-		if filepath.IsAbs(specifiedPath) {
+		if isAbsPath(t.configOrDefault().fileSystem, specifiedPath) {
 			valueFilePath = specifiedPath
 		} else {
 			valueFilePath = filepath.Join(filepath.Dir(t.definitionFile), specifiedPath)
 		}
 
-		byteArray, err := os.ReadFile(valueFilePath)
+		byteArray, err := readFile(t.configOrDefault().fileSystem, valueFilePath)
 		if err != nil {
 			return "", err
 		}
@@ -362,11 +515,59 @@ func (t *TestJob) getUserValues() (string, error) {
 	return common.YmlMarshall(base)
 }
 
-// render the chart and return result map
-func (t *TestJob) renderV3Chart(userValues []byte) (map[string]string, bool, error) {
+// timeoutContext returns the context which is cancelled after the timeout of the test job, if set.
+func (t *TestJob) timeoutContext() (context.Context, context.CancelFunc, error) {
+	timeout, err := parseTimeout(t.Timeout)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid test job timeout: %w", err)
+	}
+	if timeout == 0 {
+		return context.Background(), func() {}, nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	return ctx, cancel, nil
+}
+
+// timeoutError returns the execution error of the test job timed out during the stage.
+func (t *TestJob) timeoutError(stage string) error {
+	return fmt.Errorf("test job timed out after %s while %s: %w", t.Timeout, stage, context.DeadlineExceeded)
+}
+
+// renderV3ChartInTime render the chart like renderV3Chart, but stops waiting for the render when the context is done.
+// The template engine can not be interrupted, so only the wait is bounded: a stuck render keeps running
+// in the background until it finishes, without changing the test job.
+func (t *TestJob) renderV3ChartInTime(ctx context.Context, userValues []byte) (map[string]string, validators.RenderContext, bool, error) {
+	if ctx.Done() == nil {
+		return t.renderV3Chart(userValues)
+	}
+
+	type renderOutput struct {
+		outputOfFiles map[string]string
+		renderContext validators.RenderContext
+		renderSucceed bool
+		err           error
+	}
+	rendered := make(chan renderOutput, 1)
+	go func() {
+		outputOfFiles, renderContext, renderSucceed, err := t.renderV3Chart(userValues)
+		rendered <- renderOutput{outputOfFiles: outputOfFiles, renderContext: renderContext, renderSucceed: renderSucceed, err: err}
+	}()
+
+	select {
+	case output := <-rendered:
+		return output.outputOfFiles, output.renderContext, output.renderSucceed, output.err
+	case <-ctx.Done():
+		log.WithField(LOG_TEST_JOB, "render-v3-chart").Warnf("test job %q timed out, the render is abandoned and keeps running in the background until it finishes", t.Name)
+		return nil, validators.RenderContext{}, false, ctx.Err()
+	}
+}
+
+// render the chart and return result map, with the render context of the values.
+// It does not change the test job, so it can be abandoned on timeout.
+func (t *TestJob) renderV3Chart(userValues []byte) (map[string]string, validators.RenderContext, bool, error) {
 	values, err := v3util.ReadValues(userValues)
 	if err != nil {
-		return nil, false, err
+		return nil, validators.RenderContext{}, false, err
 	}
 	options := *t.releaseV3Option()
 
@@ -374,27 +575,28 @@ func (t *TestJob) renderV3Chart(userValues []byte) (map[string]string, bool, err
 	if t.Release.Name != "" {
 		err = v3util.ValidateReleaseName(t.Release.Name)
 		if err != nil {
-			return nil, false, err
+			return nil, validators.RenderContext{}, false, err
 		}
 	}
 
 	err = v3util.ProcessDependenciesWithMerge(t.configOrDefault().targetChart, values)
 	if err != nil {
-		return nil, false, err
+		return nil, validators.RenderContext{}, false, err
 	}
 
 	vals, err := v3util.ToRenderValuesWithSchemaValidation(t.configOrDefault().targetChart, values.AsMap(), options, t.capabilitiesV3(), t.configOrDefault().isSkipSchemaValidation)
 	if err != nil {
-		return nil, false, err
-	}
-	// When defaultTemplatesToAssert is empty, ensure all templates will be validated.
-	if len(t.defaultTemplatesToAssert) == 0 {
-		// Set all files
-		t.defaultTemplatesToAssert = []string{multiWildcard}
+		return nil, validators.RenderContext{}, false, err
 	}
+	renderContext := t.renderContextOf(vals)
 
 	// Filter the files that needs to be validated
 	filteredChart := CopyV3Chart(t.chartRoute, t.configOrDefault().targetChart.Name(), t.defaultTemplatesToAssert, t.defaultTemplatesToSkip, t.configOrDefault().targetChart)
+	if t.Helper != nil {
+		if err := t.Helper.addTo(filteredChart); err != nil {
+			return nil, renderContext, false, err
+		}
+	}
 
 	var outputOfFiles map[string]string
 	// modify chart metadata before rendering
@@ -409,9 +611,9 @@ func (t *TestJob) renderV3Chart(userValues []byte) (map[string]string, bool, err
 	outputOfFiles, renderSucceed, err = t.translateErrorToOutputFiles(err, outputOfFiles)
 	log.WithField(LOG_TEST_JOB, "render-v3-chart").Debug("outputOfFiles:", outputOfFiles, "renderSucceed:", renderSucceed, "err:", err)
 	if err != nil {
-		return nil, false, err
+		return nil, renderContext, false, err
 	}
-	return outputOfFiles, renderSucceed, nil
+	return outputOfFiles, renderContext, renderSucceed, nil
 }
 
 // MergeAndPostRender merge the map into a single file, post-render it, and split it out again
@@ -484,30 +686,86 @@ func SplitManifests(renderedManifests *bytes.Buffer) map[string]string {
 	return postRenderedManifestsMap
 }
 
-func (t *TestJob) postRender(renderedManifestsMap map[string]string) (map[string]string, bool, error) {
-
-	var cfg PostRendererConfig
-	// use job-level post-renderer if it exists; else try suite; else return what we were passed as input
-	if t.PostRendererConfig.Cmd != "" {
-		cfg = t.PostRendererConfig
-	} else if t.configOrDefault().postRenderer.Cmd != "" {
-		cfg = t.configOrDefault().postRenderer
-	} else {
-		return renderedManifestsMap, false, nil
+// postRender runs the chain of post-renderers on the rendered manifests, each on the output of the previous one.
+// It returns the output of the last post-renderer, and the output of each named post-renderer.
+func (t *TestJob) postRender(ctx context.Context, renderedManifestsMap map[string]string) (
+	map[string]string, map[string]map[string]string, bool, error,
+) {
+	// use job-level post-renderers if they exist; else try suite; else return what we were passed as input
+	chain := postRendererChain(t.PostRendererConfig, t.PostRenderers)
+	if len(chain) == 0 {
+		chain = postRendererChain(t.configOrDefault().postRenderer, t.configOrDefault().postRenderers)
+	}
+	if len(chain) == 0 {
+		return renderedManifestsMap, nil, false, nil
+	}
+	if err := validatePostRendererChain(chain); err != nil {
+		return nil, nil, true, err
 	}
 
-	postRenderer, err := postrender.NewExec(cfg.Cmd, cfg.ArgSlice...)
-	if err != nil {
-		return nil, true, err
+	chartRoute := cmp.Or(t.chartRoute, t.configOrDefault().targetChart.Name())
+	postRenderedManifestsMap := renderedManifestsMap
+	outputOfSteps := make(map[string]map[string]string)
+	for _, cfg := range chain {
+		postRenderer, err := newPostRenderer(ctx, cfg, t.configOrDefault().fileSystem, filepath.Dir(t.definitionFile), chartRoute)
+		if err != nil {
+			return nil, nil, true, err
+		}
+
+		renderedManifests, err := MergeAndPostRender(postRenderedManifestsMap, postRenderer)
+		if err != nil {
+			return nil, nil, true, err
+		}
+
+		postRenderedManifestsMap = SplitManifests(renderedManifests)
+		if cfg.Name != "" {
+			outputOfSteps[cfg.Name] = postRenderedManifestsMap
+		}
 	}
+	return postRenderedManifestsMap, outputOfSteps, true, nil
+}
 
-	renderedManifests, err := MergeAndPostRender(renderedManifestsMap, postRenderer)
+// parseAndFilterManifests parses the manifests of the output of files, and keeps the selected hooks.
+func (t *TestJob) parseAndFilterManifests(outputOfFiles map[string]string, renderSucceed bool) (
+	map[string][]common.K8sManifest, error,
+) {
+	manifestsOfFiles, err := t.parseManifestsFromOutputOfFiles(outputOfFiles, renderSucceed)
 	if err != nil {
-		return nil, true, err
+		return nil, err
 	}
+	return t.filterHooks(manifestsOfFiles)
+}
+
+// manifestsOfStages returns the manifests of the stages the assertions select,
+// the rendered manifests or the output of a named post-renderer.
+func (t *TestJob) manifestsOfStages(outputOfFiles map[string]string, outputOfSteps map[string]map[string]string, renderSucceed bool) (
+	map[string]map[string][]common.K8sManifest, error,
+) {
+	manifestsOfStages := make(map[string]map[string][]common.K8sManifest)
+	for _, assertion := range t.Assertions {
+		if assertion == nil || assertion.Stage == "" || assertion.Stage == stagePostRendered {
+			continue
+		}
+		if _, ok := manifestsOfStages[assertion.Stage]; ok {
+			continue
+		}
 
-	postRenderedManifestsMap := SplitManifests(renderedManifests)
-	return postRenderedManifestsMap, true, nil
+		outputOfStage, ok := outputOfSteps[assertion.Stage]
+		if assertion.Stage == stageRendered {
+			outputOfStage, ok = outputOfFiles, true
+		}
+		if !ok {
+			return nil, fmt.Errorf("assertion stage `%s` is not defined, expected %s, %s or the name of a post-renderer",
+				assertion.Stage, stageRendered, stagePostRendered)
+		}
+
+		manifests, err := t.parseAndFilterManifests(outputOfStage, renderSucceed)
+		if err != nil {
+			return nil, err
+		}
+		manifestsOfStages[assertion.Stage] = manifests
+	}
+	return manifestsOfStages, nil
 }
 
 // When rendering failed, due to fail or required,
@@ -542,6 +800,36 @@ func (t *TestJob) translateErrorToOutputFiles(err error, outputOfFiles map[strin
 	return outputOfFiles, renderSucceed, nil
 }
 
+// renderContextOf exposes the release, chart and values of the render values to the validators,
+// using the same names as the built-in objects of the templates.
+func (t *TestJob) renderContextOf(vals v3util.Values) validators.RenderContext {
+	renderContext := validators.RenderContext{
+		Release: map[string]any{},
+		Chart:   map[string]any{},
+		Values:  map[string]any{},
+	}
+
+	if release, ok := vals["Release"].(map[string]any); ok {
+		renderContext.Release = release
+	}
+	if values, err := vals.Table("Values"); err == nil {
+		renderContext.Values = values.AsMap()
+	}
+	if metadata, ok := vals["Chart"].(*v3chart.Metadata); ok && metadata != nil {
+		renderContext.Chart = map[string]any{
+			"Name":        metadata.Name,
+			"Version":     metadata.Version,
+			"AppVersion":  metadata.AppVersion,
+			"Description": metadata.Description,
+			"Type":        metadata.Type,
+			"APIVersion":  metadata.APIVersion,
+			"KubeVersion": metadata.KubeVersion,
+			"Annotations": metadata.Annotations,
+		}
+	}
+	return renderContext
+}
+
 // get chartutil.ReleaseOptions ready for render
 func (t *TestJob) releaseV3Option() *v3util.ReleaseOptions {
 	options := v3util.ReleaseOptions{
@@ -620,14 +908,60 @@ func (t *TestJob) parseManifestsFromOutputOfFiles(outputOfFiles map[string]strin
 				return nil, err
 			}
 			manifestsOfFiles[file] = manifest
+		case ".json":
+			manifest, err := parseJsonFile(rendered)
+			if err != nil {
+				return nil, err
+			}
+			manifestsOfFiles[file] = manifest
 		case ".txt":
 			manifestsOfFiles[file] = parseTextFile(rendered)
+		default:
+			// Other text outputs are only kept when opted in, and rendered to skip empty or placeholder files.
+			if t.isRawExtension(fileExtension) && strings.TrimSpace(rendered) != "" {
+				manifestsOfFiles[file] = parseTextFile(rendered)
+			}
 		}
 	}
 
 	return manifestsOfFiles, nil
 }
 
+// isRawExtension returns whether the rendered outputs with the file extension are kept as raw documents,
+// the extensions of RawExtensions may be given with or without the leading dot.
+func (t *TestJob) isRawExtension(fileExtension string) bool {
+	for _, extension := range t.RawExtensions {
+		if "."+strings.TrimPrefix(extension, ".") == fileExtension {
+			return true
+		}
+	}
+	return false
+}
+
+// filterHooks includes, excludes or only keeps the Helm hooks (including test hooks) of the rendered manifests.
+// Raw documents (like NOTES.txt) are never filtered.
+func (t *TestJob) filterHooks(manifestsOfFiles map[string][]common.K8sManifest) (map[string][]common.K8sManifest, error) {
+	switch t.Hooks {
+	case "", hooksInclude:
+		return manifestsOfFiles, nil
+	case hooksExclude, hooksOnly:
+	default:
+		return nil, fmt.Errorf("invalid hooks option '%s', expected one of %s, %s or %s", t.Hooks, hooksInclude, hooksExclude, hooksOnly)
+	}
+
+	filteredManifestsOfFiles := make(map[string][]common.K8sManifest, len(manifestsOfFiles))
+	for file, manifests := range manifestsOfFiles {
+		filtered := make([]common.K8sManifest, 0, len(manifests))
+		for _, manifest := range manifests {
+			if _, isRaw := manifest[common.RAW]; isRaw || common.IsHook(manifest) == (t.Hooks == hooksOnly) {
+				filtered = append(filtered, manifest)
+			}
+		}
+		filteredManifestsOfFiles[file] = filtered
+	}
+	return filteredManifestsOfFiles, nil
+}
+
 // run Assert of all assertions of test
 func (t *TestJob) runAssertions(
 	cfg AssertionConfig,
@@ -640,7 +974,7 @@ func (t *TestJob) runAssertions(
 			continue
 		}
 
-		assertion.WithConfig(cfg)
+		assertion.WithConfig(cfg.ofStage(assertion.Stage))
 		result := assertion.Assert(
 			&results.AssertionResult{Index: idx},
 		)
@@ -207,6 +207,46 @@ asserts:
 	a.Equal(2, len(testResult.AssertsResult))
 }
 
+func TestV3RunJobWithQuantifierAcrossTemplatesOk(t *testing.T) {
+	c, _ := loader.Load(testV3BasicChart)
+	manifest := `
+it: should work
+templates:
+  - templates/deployment.yaml
+  - templates/configmap.yaml
+asserts:
+  - some:
+      asserts:
+        - isKind:
+            of: ConfigMap
+  - none:
+      asserts:
+        - isKind:
+            of: Secret
+  - every:
+      asserts:
+        - exists:
+            path: metadata.name
+  - every:
+      path: spec.template.spec.containers
+      asserts:
+        - exists:
+            path: image
+    template: templates/deployment.yaml
+`
+	var tj TestJob
+	common.YmlUnmarshalTestHelper(manifest, &tj, t)
+
+	cfg := NewTestConfig(c, &snapshot.Cache{})
+	tj.WithConfig(*cfg)
+	testResult := tj.RunV3(&results.TestJobResult{})
+
+	a := assert.New(t)
+	a.NoError(testResult.ExecError)
+	a.True(testResult.Passed, testResult.AssertsResult)
+	a.Equal(4, len(testResult.AssertsResult))
+}
+
 func TestV3RunJobWithTestJobTemplatesOk(t *testing.T) {
 	c, _ := loader.Load(testV3BasicChart)
 	manifest := `
@@ -301,6 +341,36 @@ asserts:
 	a.Equal(2, len(testResult.AssertsResult))
 }
 
+func TestV3RunJobWithDescriptions(t *testing.T) {
+	c, _ := loader.Load(testV3BasicChart)
+	manifest := `
+it: should work
+message: deployments follow the naming convention
+asserts:
+  - equal:
+      path: kind
+      value: Deployment
+    template: templates/deployment.yaml
+    description: the kind is right
+  - matchRegex:
+      path: metadata.name
+      pattern: pattern-not-match
+    template: templates/deployment.yaml
+    description: names end with the pattern
+`
+	var tj TestJob
+	common.YmlUnmarshalTestHelper(manifest, &tj, t)
+
+	tj.WithConfig(*NewTestConfig(c, &snapshot.Cache{}))
+	testResult := tj.RunV3(&results.TestJobResult{})
+
+	a := assert.New(t)
+	a.NoError(testResult.ExecError)
+	a.False(testResult.Passed)
+	a.Equal("deployments follow the naming convention", testResult.Description)
+	a.Equal("Failed: deployments follow the naming convention; names end with the pattern", testResult.FailureMessage())
+}
+
 func TestV3RunJobWithAssertionFailFast(t *testing.T) {
 	c, _ := loader.Load(testV3BasicChart)
 	manifest := `
@@ -1350,3 +1420,141 @@ asserts:
 	a.True(testResult.Passed)
 	a.Equal(1, len(testResult.AssertsResult))
 }
+
+func TestV3RunJobWithInvalidHooksOption(t *testing.T) {
+	c, _ := loader.Load(testV3BasicChart)
+	manifest := `
+it: should fail on an invalid hooks option
+hooks: all
+asserts:
+  - isKind:
+      of: Deployment
+    template: templates/deployment.yaml
+`
+	var tj TestJob
+	common.YmlUnmarshalTestHelper(manifest, &tj, t)
+
+	cfg := NewTestConfig(c, &snapshot.Cache{})
+	tj.WithConfig(*cfg)
+	testResult := tj.RunV3(&results.TestJobResult{})
+
+	a := assert.New(t)
+	a.EqualError(testResult.ExecError, "invalid hooks option 'all', expected one of include, exclude or only")
+	a.False(testResult.Passed)
+}
+
+func TestV3RunJobWithInvalidHelper(t *testing.T) {
+	c, _ := loader.Load(testV3BasicChart)
+	manifest := `
+it: should fail on an invalid helper
+helper:
+  include: basic.fullname
+  tpl: "{{ .Values.foo }}"
+asserts:
+  - equalRaw:
+      value: foo
+`
+	var tj TestJob
+	common.YmlUnmarshalTestHelper(manifest, &tj, t)
+
+	cfg := NewTestConfig(c, &snapshot.Cache{})
+	tj.WithConfig(*cfg)
+	testResult := tj.RunV3(&results.TestJobResult{})
+
+	a := assert.New(t)
+	a.EqualError(testResult.ExecError, "helper requires exactly one of 'include' or 'tpl' to be defined")
+	a.False(testResult.Passed)
+}
+
+func TestV3RunJobWithHelperOk(t *testing.T) {
+	c, _ := loader.Load(testV3BasicChart)
+	manifest := `
+it: should render the helper only
+helper:
+  tpl: "{{ .Release.Name }}-{{ .Chart.Name }}"
+asserts:
+  - equalRaw:
+      value: RELEASE-NAME-basic
+  - hasDocuments:
+      count: 1
+`
+	var tj TestJob
+	common.YmlUnmarshalTestHelper(manifest, &tj, t)
+
+	cfg := NewTestConfig(c, &snapshot.Cache{})
+	tj.WithConfig(*cfg)
+	testResult := tj.RunV3(&results.TestJobResult{})
+
+	a := assert.New(t)
+	a.NoError(testResult.ExecError)
+	a.True(testResult.Passed, testResult.AssertsResult)
+}
+
+func TestV3RunJobWithExpressionOk(t *testing.T) {
+	c, _ := loader.Load(testV3BasicChart)
+	manifest := `
+it: should evaluate expressions with the render context
+release:
+  name: my-release
+set:
+  replicaCount: 3
+template: templates/deployment.yaml
+documentIndex: 0
+asserts:
+  - expression: object.spec.replicas == values.replicaCount
+  - expression:
+      expression: object.metadata.name == release.Name + '-' + chart.Name && size(documents) == 2
+  - expression: object.spec.template.spec.containers[0].name != chart.Name
+    not: true
+`
+	var tj TestJob
+	common.YmlUnmarshalTestHelper(manifest, &tj, t)
+
+	cfg := NewTestConfig(c, &snapshot.Cache{})
+	tj.WithConfig(*cfg)
+	testResult := tj.RunV3(&results.TestJobResult{})
+
+	a := assert.New(t)
+	a.NoError(testResult.ExecError)
+	a.True(testResult.Passed, testResult.AssertsResult)
+	a.Equal(3, len(testResult.AssertsResult))
+}
+
+func TestV3RunJobWithoutRawExtensionsIgnoresOtherTextOutputs(t *testing.T) {
+	c, _ := loader.Load("testdata/chart-text-outputs")
+	manifest := `
+it: should ignore the nginx.conf without rawExtensions
+template: nginx.conf
+asserts:
+  - containsLine:
+      line: listen 8080;
+`
+	var tj TestJob
+	common.YmlUnmarshalTestHelper(manifest, &tj, t)
+	tj.WithConfig(*NewTestConfig(c, &snapshot.Cache{}))
+	testResult := tj.RunV3(&results.TestJobResult{})
+
+	assert.NoError(t, testResult.ExecError)
+	assert.False(t, testResult.Passed)
+	assert.Contains(t, testResult.Stringify(), `template "text-outputs/templates/nginx.conf" not exists or not selected in test suite`)
+}
+
+func TestV3RunJobWithHelperKeepsLibraryChartType(t *testing.T) {
+	c, _ := loader.Load("testdata/chart-library")
+	manifest := `
+it: should render the name
+helper:
+  include: library.name
+asserts:
+  - equalRaw:
+      value: library
+`
+	var tj TestJob
+	common.YmlUnmarshalTestHelper(manifest, &tj, t)
+	tj.WithConfig(*NewTestConfig(c, &snapshot.Cache{}))
+	testResult := tj.RunV3(&results.TestJobResult{})
+
+	assert.NoError(t, testResult.ExecError)
+	assert.True(t, testResult.Passed, testResult.Stringify())
+	assert.Equal(t, "library", c.Metadata.Type)
+}
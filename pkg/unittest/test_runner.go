@@ -3,7 +3,7 @@ package unittest
 import (
 	"errors"
 	"fmt"
-	"os"
+	"io/fs"
 	"path/filepath"
 	"strings"
 	"time"
@@ -16,61 +16,11 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	v3chart "helm.sh/helm/v3/pkg/chart"
-	v3loader "helm.sh/helm/v3/pkg/chart/loader"
 	v3util "helm.sh/helm/v3/pkg/chartutil"
 )
 
 const LOG_TEST_RUNNER = "test-runner"
 
-// testUnitCounting stores counting numbers of test unit status
-type testUnitCounting struct {
-	passed  uint
-	failed  uint
-	errored uint
-	skipped uint
-}
-
-// sprint returns string of counting result
-func (counting testUnitCounting) sprint(printer *printer.Printer) string {
-	var failedLabel string
-	if counting.failed > 0 {
-		failedLabel = printer.Danger("%d failed, ", counting.failed)
-	}
-	var erroredLabel string
-	if counting.errored > 0 {
-		erroredLabel = fmt.Sprintf("%d errored, ", counting.errored)
-	}
-	result := failedLabel + erroredLabel
-	if counting.skipped > 0 {
-		result += fmt.Sprintf(
-			"%d passed, %d skipped, %d total",
-			counting.passed,
-			counting.skipped,
-			counting.passed+counting.failed+counting.skipped,
-		)
-	} else {
-		result += fmt.Sprintf(
-			"%d passed, %d total",
-			counting.passed,
-			counting.passed+counting.failed,
-		)
-	}
-	return result
-}
-
-// testUnitCountingWithSnapshotFailed store testUnitCounting with snapshotFailed field
-type testUnitCountingWithSnapshotFailed struct {
-	testUnitCounting
-	snapshotFailed uint
-}
-
-// totalSnapshotCounting store testUnitCounting with snapshotFailed field
-type totalSnapshotCounting struct {
-	testUnitCounting
-	created  uint
-	vanished uint
-}
-
 // TestRunner stores basic settings and testing status for running all tests
 type TestRunner struct {
 	Printer              *printer.Printer
@@ -85,33 +35,42 @@ type TestRunner struct {
 	ValuesFiles          []string
 	OutputFile           string
 	RenderPath           string
-	suiteCounting        testUnitCountingWithSnapshotFailed
-	testCounting         testUnitCounting
-	chartCounting        testUnitCounting
-	snapshotCounting     totalSnapshotCounting
-	testResults          []*results.TestSuiteResult
+	// FileSystem the charts, test suites and values files are read from, instead of the OS file system when set
+	FileSystem fs.FS
+	// SnapshotStore the snapshot files are read from and written to, instead of the OS file system when set.
+	// Default to a snapshot.MemoryStore of the FileSystem, when the FileSystem is set.
+	SnapshotStore snapshot.Store
+	// Listeners are notified of the events of the test run, after the Formatter and the Printer
+	Listeners []RunListener
+	listeners runListeners
+}
+
+// Subscribe adds listeners which are notified of the events of the test runs.
+func (tr *TestRunner) Subscribe(listeners ...RunListener) {
+	tr.Listeners = append(tr.Listeners, listeners...)
+}
+
+// runListeners returns the listeners of a test run, the Formatter and the Printer followed by the Listeners.
+func (tr *TestRunner) runListeners() runListeners {
+	listeners := make(runListeners, 0, len(tr.Listeners)+2)
+	if tr.Formatter != nil {
+		listeners = append(listeners, newFormatterListener(tr.Formatter, tr.OutputFile, tr.Printer))
+	}
+	if tr.Printer != nil {
+		listeners = append(listeners, newPrinterListener(tr.Printer, tr.Failfast))
+	}
+	return append(listeners, tr.Listeners...)
 }
 
 // RunV3 test suites in chart in ChartPaths.
 func (tr *TestRunner) RunV3(ChartPaths []string) bool {
+	tr.listeners = tr.runListeners()
 	allPassed := true
 	start := time.Now()
 	for _, chartPath := range ChartPaths {
-		chart, err := v3loader.Load(chartPath)
-		if err != nil {
-			tr.printErroredChartHeader(err)
-			tr.countChart(false, err)
-			allPassed = false
-			if tr.Failfast {
-				break
-			}
-			continue
-		}
-		chartRoute := chart.Name()
-		testSuites, err := tr.getV3TestSuites(chartPath, chartRoute, chart)
+		chart, testSuites, err := tr.LoadV3TestSuites(chartPath)
 		if err != nil {
-			tr.printErroredChartHeader(err)
-			tr.countChart(false, err)
+			tr.listeners.notify(func(listener RunListener) { listener.OnChartFinished(chartPath, false, err) })
 			allPassed = false
 			if tr.Failfast {
 				break
@@ -119,19 +78,34 @@ func (tr *TestRunner) RunV3(ChartPaths []string) bool {
 			continue
 		}
 
-		tr.printChartHeader(chart.Name(), chartPath)
+		tr.listeners.notify(func(listener RunListener) { listener.OnChartStart(chart.Name(), chartPath) })
 		chartPassed := tr.runV3SuitesOfChart(testSuites, chart)
 
-		tr.countChart(chartPassed, nil)
+		tr.listeners.notify(func(listener RunListener) { listener.OnChartFinished(chartPath, chartPassed, nil) })
 		allPassed = allPassed && chartPassed
 	}
-	err := tr.writeTestOutput()
+	elapsed := time.Since(start)
+	tr.listeners.notify(func(listener RunListener) { listener.OnRunFinished(allPassed, elapsed) })
+	return allPassed
+}
+
+// LoadV3TestSuites loads the chart in chartPath with its test suites, including the test suites of
+// the enabled subcharts when WithSubChart is set, to run the test suites one by one.
+func (tr *TestRunner) LoadV3TestSuites(chartPath string) (*v3chart.Chart, []*TestSuite, error) {
+	chart, err := loadChart(tr.FileSystem, chartPath)
 	if err != nil {
-		tr.printErroredChartHeader(err)
+		return nil, nil, err
 	}
-	tr.printSnapshotSummary()
-	tr.printSummary(time.Since(start))
-	return allPassed
+
+	testSuites, err := tr.getV3TestSuites(chartPath, chart.Name(), chart)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, suite := range testSuites {
+		suite.skipSchemaValidation = tr.SkipSchemaValidation
+	}
+	return chart, testSuites, nil
 }
 
 // getTestSuites retrieves the list of test suites for the given chart.
@@ -142,12 +116,12 @@ func (tr *TestRunner) RunV3(ChartPaths []string) bool {
 //
 // It returns a slice of _TestSuite structs and an error if any occurred during processing.
 func (tr *TestRunner) getTestSuites(chartPath, chartRoute string) ([]*TestSuite, error) {
-	testFilesSet, terr := GetFiles(chartPath, tr.TestFiles, false)
+	testFilesSet, terr := GetFilesFS(tr.FileSystem, chartPath, tr.TestFiles, false)
 	if terr != nil {
 		return nil, terr
 	}
 
-	valuesFilesSet, verr := GetFiles("", tr.ValuesFiles, true)
+	valuesFilesSet, verr := GetFilesFS(tr.FileSystem, "", tr.ValuesFiles, true)
 	if verr != nil {
 		return nil, verr
 	}
@@ -156,9 +130,9 @@ func (tr *TestRunner) getTestSuites(chartPath, chartRoute string) ([]*TestSuite,
 	if len(tr.ChartTestsPath) > 0 {
 		helmTestsPath := filepath.Join(chartPath, tr.ChartTestsPath)
 		// Verify that there is a tests path - in the event of mixed testing environments
-		if _, err := os.Stat(helmTestsPath); errors.Is(err, nil) {
+		if _, err := statFile(tr.FileSystem, helmTestsPath); errors.Is(err, nil) {
 			var renderErr error
-			renderedTestSuites, renderErr = RenderTestSuiteFiles(helmTestsPath, chartRoute, tr.Strict, valuesFilesSet, nil)
+			renderedTestSuites, renderErr = renderTestSuiteFiles(tr.FileSystem, helmTestsPath, chartRoute, tr.Strict, valuesFilesSet, nil)
 			if renderErr != nil {
 				return nil, renderErr
 			}
@@ -167,9 +141,9 @@ func (tr *TestRunner) getTestSuites(chartPath, chartRoute string) ([]*TestSuite,
 
 	resultSuites := make([]*TestSuite, 0, len(testFilesSet)+len(renderedTestSuites))
 	for _, file := range testFilesSet {
-		suites, err := ParseTestSuiteFile(file, chartRoute, tr.Strict, valuesFilesSet)
+		suites, err := ParseTestSuiteFS(tr.FileSystem, file, chartRoute, tr.Strict, valuesFilesSet)
 		if err != nil {
-			tr.handleSuiteResult(&results.TestSuiteResult{
+			tr.notifySuiteFinished(&results.TestSuiteResult{
 				FilePath:  file,
 				ExecError: err,
 			})
@@ -196,11 +170,11 @@ func (tr *TestRunner) buildMergedValuesForChart(chart *v3chart.Chart, chartPath
 
 	for _, valuesFile := range tr.ValuesFiles {
 		valuesPath := valuesFile
-		if !filepath.IsAbs(valuesFile) {
+		if !isAbsPath(tr.FileSystem, valuesFile) {
 			valuesPath = filepath.Join(chartPath, valuesFile)
 		}
 
-		byteArray, err := os.ReadFile(valuesPath)
+		byteArray, err := readFile(tr.FileSystem, valuesPath)
 		if err != nil {
 			return nil, fmt.Errorf("failed to read values file %s: %w", valuesFile, err)
 		}
@@ -422,6 +396,19 @@ func (tr *TestRunner) getV3TestSuitesWithValues(chartPath, chartRoute string, ch
 	return resultSuites, nil
 }
 
+// SnapshotCacheOfSuite creates the snapshot cache of the test suite, which reads and writes the snapshot file
+// with the SnapshotStore. When the FileSystem is set without a SnapshotStore, a snapshot.MemoryStore of the
+// FileSystem becomes the SnapshotStore, otherwise the snapshot file is read and written on the OS file system.
+func (tr *TestRunner) SnapshotCacheOfSuite(suite *TestSuite) (*snapshot.Cache, error) {
+	if tr.SnapshotStore == nil && tr.FileSystem != nil {
+		tr.SnapshotStore = snapshot.NewMemoryStore(tr.FileSystem)
+	}
+	if tr.SnapshotStore == nil {
+		return snapshot.CreateSnapshotOfSuite(suite.SnapshotFileUrl(), tr.UpdateSnapshot)
+	}
+	return snapshot.CreateSnapshotOfSuiteInStore(tr.SnapshotStore, suite.SnapshotFileUrl(), tr.UpdateSnapshot)
+}
+
 // getV3TestSuites retrieves test suites for the given chart and its dependencies (if WithSubChart is true).
 // This is a convenience wrapper that automatically computes merged values.
 //
@@ -430,32 +417,35 @@ func (tr *TestRunner) getV3TestSuites(chartPath, chartRoute string, chart *v3cha
 	return tr.getV3TestSuitesWithValues(chartPath, chartRoute, chart, nil)
 }
 
-// runV3SuitesOfChart runs suite files of the chart and print output
+// runV3SuitesOfChart runs suite files of the chart and notify the listeners
 func (tr *TestRunner) runV3SuitesOfChart(suites []*TestSuite, chart *v3chart.Chart) bool {
 	chartPassed := true
 	for _, suite := range suites {
-		snapshotCache, err := snapshot.CreateSnapshotOfSuite(suite.SnapshotFileUrl(), tr.UpdateSnapshot)
+		tr.listeners.notify(func(listener RunListener) { listener.OnSuiteStart(suite) })
+
+		snapshotCache, err := tr.SnapshotCacheOfSuite(suite)
 		if err != nil {
-			tr.handleSuiteResult(&results.TestSuiteResult{
+			tr.notifySuiteFinished(&results.TestSuiteResult{
 				FilePath:  suite.definitionFile,
 				ExecError: err,
 			})
 			chartPassed = false
 			continue
 		}
-		suite.skipSchemaValidation = tr.SkipSchemaValidation
+		suite.listeners = tr.listeners
 		result := suite.RunV3(chart, snapshotCache, tr.Failfast, tr.RenderPath, &results.TestSuiteResult{})
 		chartPassed = chartPassed && result.Passed
-		tr.handleSuiteResult(result)
-		tr.testResults = append(tr.testResults, result)
+		tr.notifySuiteFinished(result)
 
-		_, storeErr := snapshotCache.StoreToFileIfNeeded()
+		stored, storeErr := snapshotCache.StoreToFileIfNeeded()
 		if storeErr != nil {
-			tr.handleSuiteResult(&results.TestSuiteResult{
+			tr.notifySuiteFinished(&results.TestSuiteResult{
 				FilePath:  suite.SnapshotFileUrl(),
 				ExecError: storeErr,
 			})
 			chartPassed = false
+		} else if stored {
+			tr.listeners.notify(func(listener RunListener) { listener.OnSnapshotChanged(suite, snapshotCache) })
 		}
 
 		if !chartPassed && result.FailFast {
@@ -466,147 +456,7 @@ func (tr *TestRunner) runV3SuitesOfChart(suites []*TestSuite, chart *v3chart.Cha
 	return chartPassed
 }
 
-// handleSuiteResult print suite result and count suites and tests status
-func (tr *TestRunner) handleSuiteResult(result *results.TestSuiteResult) {
-	result.Print(tr.Printer, 0)
-	tr.countSuite(result)
-	for _, testsResult := range result.TestsResult {
-		if testsResult == nil {
-			if tr.Failfast {
-				log.WithField("test-runner", "handle-suite-result").Debug("--failfast skip test")
-			}
-			continue
-		}
-		tr.countTest(testsResult)
-	}
-}
-
-// printSummary print summary footer
-func (tr *TestRunner) printSummary(elapsed time.Duration) {
-	summaryFormat := `
-Charts:      %s
-Test Suites: %s
-Tests:       %s
-Snapshot:    %s
-Time:        %s
-`
-	tr.Printer.Println(
-		fmt.Sprintf(
-			summaryFormat,
-			tr.chartCounting.sprint(tr.Printer),
-			tr.suiteCounting.sprint(tr.Printer),
-			tr.testCounting.sprint(tr.Printer),
-			tr.snapshotCounting.sprint(tr.Printer),
-			elapsed.String(),
-		),
-		0,
-	)
-
-}
-
-// printChartHeader print header before suite result of a chart
-func (tr *TestRunner) printChartHeader(chartName, path string) {
-	headerFormat := `
-### Chart [ %s ] %s
-`
-	header := fmt.Sprintf(
-		headerFormat,
-		tr.Printer.Highlight("%s", chartName),
-		tr.Printer.Faint("%s", path),
-	)
-	tr.Printer.Println(header, 0)
-}
-
-// printErroredChartHeader if chart has exexution error print header with error
-func (tr *TestRunner) printErroredChartHeader(err error) {
-	headerFormat := `
-### ` + tr.Printer.Danger("%s", "Error: ") + ` %s
-`
-	header := fmt.Sprintf(headerFormat, err)
-	tr.Printer.Println(header, 0)
-}
-
-// printSnapshotSummary print snapshot summary in footer
-func (tr *TestRunner) printSnapshotSummary() {
-	if tr.snapshotCounting.failed > 0 {
-		snapshotFormat := `
-Snapshot Summary: %s`
-
-		summary := tr.Printer.Danger("%d snapshot failed", tr.snapshotCounting.failed) +
-			fmt.Sprintf(" in %d test suite.", tr.suiteCounting.snapshotFailed) +
-			tr.Printer.Faint("%s", " Check changes and use `-u` to update snapshot.")
-
-		tr.Printer.Println(fmt.Sprintf(snapshotFormat, summary), 0)
-	}
-}
-
-// countSuite count suite status and snapshot status
-func (tr *TestRunner) countSuite(suite *results.TestSuiteResult) {
-	if suite.Skipped {
-		tr.suiteCounting.skipped++
-	} else if suite.Passed {
-		tr.suiteCounting.passed++
-	} else {
-		tr.suiteCounting.failed++
-		if suite.ExecError != nil {
-			tr.suiteCounting.errored++
-		}
-		if suite.SnapshotCounting.Failed > 0 {
-			tr.suiteCounting.snapshotFailed++
-		}
-	}
-	tr.snapshotCounting.failed += suite.SnapshotCounting.Failed
-	tr.snapshotCounting.passed += suite.SnapshotCounting.Total - suite.SnapshotCounting.Failed
-	tr.snapshotCounting.created += suite.SnapshotCounting.Created
-	tr.snapshotCounting.vanished += suite.SnapshotCounting.Vanished
-}
-
-// countTest count test status
-func (tr *TestRunner) countTest(test *results.TestJobResult) {
-	if test.Passed {
-		tr.testCounting.passed++
-	} else if test.Skipped {
-		tr.testCounting.skipped++
-	} else {
-		tr.testCounting.failed++
-		if test.ExecError != nil {
-			tr.testCounting.errored++
-		}
-	}
-}
-
-func (tr *TestRunner) countChart(passed bool, err error) {
-	if passed {
-		tr.chartCounting.passed++
-	} else {
-		tr.chartCounting.failed++
-		if err != nil {
-			tr.chartCounting.errored++
-		}
-	}
-}
-
-func (tr *TestRunner) writeTestOutput() error {
-	// Check if formatter exits to write
-	if tr.Formatter != nil {
-		// Create outputfile for testsuite
-		writer, ferr := os.Create(tr.OutputFile)
-		if ferr != nil {
-			return ferr
-		}
-		defer func() {
-			werr := writer.Close()
-			if werr != nil {
-				log.WithField(LOG_TEST_RUNNER, "write-test-output").Errorf("Error closing output file: %s", werr)
-			}
-		}()
-
-		//
-		jerr := tr.Formatter.WriteTestOutput(tr.testResults, true, writer)
-		if jerr != nil {
-			return jerr
-		}
-	}
-
-	return nil
+// notifySuiteFinished notify the listeners of the result of the test suite
+func (tr *TestRunner) notifySuiteFinished(result *results.TestSuiteResult) {
+	tr.listeners.notify(func(listener RunListener) { listener.OnSuiteFinished(result) })
 }
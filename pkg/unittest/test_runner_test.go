@@ -3,6 +3,7 @@ package unittest_test
 import (
 	"bytes"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -10,12 +11,15 @@ import (
 	"strings"
 	"testing"
 	"testing/fstest"
+	"time"
 
 	"github.com/bradleyjkemp/cupaloy/v2"
 	"github.com/helm-unittest/helm-unittest/internal/common"
 	. "github.com/helm-unittest/helm-unittest/pkg/unittest"
 	"github.com/helm-unittest/helm-unittest/pkg/unittest/formatter"
 	"github.com/helm-unittest/helm-unittest/pkg/unittest/printer"
+	"github.com/helm-unittest/helm-unittest/pkg/unittest/results"
+	"github.com/helm-unittest/helm-unittest/pkg/unittest/snapshot"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -96,6 +100,126 @@ func TestV3RunnerOkWithPassedTests(t *testing.T) {
 	cupaloy.SnapshotT(t, makeOutputSnapshotable(buffer.String())...)
 }
 
+func TestV3RunnerLoadTestSuites(t *testing.T) {
+	runner := TestRunner{
+		TestFiles:            []string{"tests/deployment_test.yaml"},
+		SkipSchemaValidation: true,
+	}
+	chart, suites, err := runner.LoadV3TestSuites(testV3BasicChart)
+	assert.NoError(t, err)
+	assert.Equal(t, "basic", chart.Name())
+	assert.Len(t, suites, 1)
+	assert.Equal(t, "test deployment", suites[0].Name)
+	assert.Equal(t, filepath.Join(testV3BasicChart, "tests", "deployment_test.yaml"), suites[0].DefinitionFile())
+}
+
+func TestV3RunnerLoadTestSuitesInvalidChartDir(t *testing.T) {
+	runner := TestRunner{TestFiles: []string{testTestFiles}}
+	_, _, err := runner.LoadV3TestSuites(testTestFiles)
+	assert.Error(t, err)
+}
+
+// recordingListener records the events of a test run.
+type recordingListener struct {
+	BaseRunListener
+	events           []string
+	assertions       int
+	changedSnapshots []string
+	passed           bool
+}
+
+func (l *recordingListener) OnChartStart(chartName, _ string) {
+	l.events = append(l.events, "chart start "+chartName)
+}
+
+func (l *recordingListener) OnChartFinished(_ string, passed bool, err error) {
+	l.events = append(l.events, fmt.Sprintf("chart finished %t %t", passed, err != nil))
+}
+
+func (l *recordingListener) OnSuiteStart(suite *TestSuite) {
+	l.events = append(l.events, "suite start "+suite.Name)
+}
+
+func (l *recordingListener) OnSuiteFinished(result *results.TestSuiteResult) {
+	l.events = append(l.events, "suite finished "+result.DisplayName)
+}
+
+func (l *recordingListener) OnJobFinished(_ *TestSuite, result *results.TestJobResult) {
+	l.events = append(l.events, "job finished "+result.DisplayName)
+}
+
+func (l *recordingListener) OnAssertion(_ *TestSuite, _ *results.TestJobResult, _ *results.AssertionResult) {
+	l.assertions++
+}
+
+func (l *recordingListener) OnSnapshotChanged(_ *TestSuite, cache *snapshot.Cache) {
+	l.changedSnapshots = append(l.changedSnapshots, filepath.Base(cache.Filepath))
+}
+
+func (l *recordingListener) OnRunFinished(passed bool, _ time.Duration) {
+	l.events = append(l.events, "run finished")
+	l.passed = passed
+}
+
+func TestV3RunnerNotifiesListeners(t *testing.T) {
+	first, second := &recordingListener{}, &recordingListener{}
+	runner := TestRunner{
+		TestFiles: []string{"tests/service_test.yaml"},
+	}
+	runner.Subscribe(first, second)
+
+	passed := runner.RunV3([]string{testV3BasicChart})
+	assert.True(t, passed)
+
+	expectedEvents := []string{
+		"chart start basic",
+		"suite start test service",
+		"job finished should pass",
+		"job finished should render right if values given",
+		"job finished should contain document",
+		"job finished should skip test",
+		"suite finished test service",
+		"chart finished true false",
+		"run finished",
+	}
+	for _, listener := range []*recordingListener{first, second} {
+		assert.Equal(t, expectedEvents, listener.events)
+		assert.True(t, listener.passed)
+		// the assertions of the skipped test job do not run
+		assert.Equal(t, 6, listener.assertions)
+		assert.Empty(t, listener.changedSnapshots)
+	}
+}
+
+func TestV3RunnerNotifiesListenersWhenChartErrored(t *testing.T) {
+	listener := &recordingListener{}
+	runner := TestRunner{
+		TestFiles: []string{testTestFiles},
+		Listeners: []RunListener{listener},
+	}
+
+	passed := runner.RunV3([]string{testTestFiles})
+	assert.False(t, passed)
+	assert.Equal(t, []string{"chart finished false true", "run finished"}, listener.events)
+	assert.False(t, listener.passed)
+}
+
+func TestV3RunnerNotifiesListenersWhenSnapshotChanged(t *testing.T) {
+	chartPath := t.TempDir()
+	assert.NoError(t, os.CopyFS(chartPath, os.DirFS(testV3BasicChart)))
+	assert.NoError(t, os.RemoveAll(filepath.Join(chartPath, "tests", "__snapshot__")))
+
+	listener := &recordingListener{}
+	runner := TestRunner{
+		TestFiles: []string{"tests/notes_test.yaml"},
+		Listeners: []RunListener{listener},
+	}
+
+	passed := runner.RunV3([]string{chartPath})
+	assert.True(t, passed)
+	assert.Equal(t, []string{"notes_test.yaml.snap"}, listener.changedSnapshots)
+}
+
 func TestV3RunnerOkWithPassedTestsDifferentFormatter(t *testing.T) {
 	outputFile := "output.txt"
 	buffer := new(bytes.Buffer)
@@ -575,3 +699,329 @@ func TestV3RunnerOkWithSkippedTestsWhenSubchartDisabledOnTags(t *testing.T) {
 	assert.Contains(t, buffer.String(), "Charts:      1 passed, 1 total")
 	assert.Contains(t, buffer.String(), "Test Suites: 4 passed, 4 total")
 }
+
+func TestV3RunnerOkWithFileSystem(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	runner := TestRunner{
+		Printer:    printer.NewPrinter(buffer, nil),
+		TestFiles:  []string{testTestFiles},
+		FileSystem: os.DirFS(testV3BasicChart),
+	}
+
+	passed := runner.RunV3([]string{"."})
+	assert.True(t, passed, buffer.String())
+
+	// the existing snapshots of the file system are compared, not written
+	store, ok := runner.SnapshotStore.(*snapshot.MemoryStore)
+	assert.True(t, ok)
+	assert.Empty(t, store.Files())
+}
+
+func TestV3RunnerWithFileSystemWritesSnapshotsToStore(t *testing.T) {
+	chart := `
+apiVersion: v2
+name: in-memory
+version: 0.1.0
+`
+	configMap := `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Release.Name }}
+data:
+  level: {{ .Values.level | quote }}
+`
+	suite := `
+suite: configmap
+templates:
+  - configmap.yaml
+values:
+  - values/debug.yaml
+tests:
+  - it: should match the snapshot
+    asserts:
+      - matchSnapshot: {}
+      - equal:
+          path: data.level
+          value: debug
+`
+	fsys := fstest.MapFS{
+		"charts/in-memory/Chart.yaml":                 {Data: []byte(chart)},
+		"charts/in-memory/.helmignore":                {Data: []byte("*.bak\n")},
+		"charts/in-memory/templates/configmap.yaml":   {Data: []byte(configMap)},
+		"charts/in-memory/templates/broken.yaml.bak":  {Data: []byte("{{ .Broken")},
+		"charts/in-memory/tests/configmap_test.yaml":  {Data: []byte(suite)},
+		"charts/in-memory/tests/values/debug.yaml":    {Data: []byte("level: debug\n")},
+		"charts/in-memory/tests/nested/ignored.yaml":  {Data: []byte("not a suite")},
+		"charts/in-memory/tests/nested/other_test.md": {Data: []byte("not a suite")},
+	}
+	store := snapshot.NewMemoryStore(fsys)
+	runner := TestRunner{
+		TestFiles:     []string{"tests/**/*_test.yaml"},
+		FileSystem:    fsys,
+		SnapshotStore: store,
+	}
+
+	passed := runner.RunV3([]string{"charts/in-memory"})
+	assert.True(t, passed)
+
+	files := store.Files()
+	assert.Len(t, files, 1)
+	assert.Contains(t, string(files["charts/in-memory/tests/__snapshot__/configmap_test.yaml.snap"]), "level: debug")
+}
+
+func TestV3RunnerWithFileSystemInvalidChartDir(t *testing.T) {
+	runner := TestRunner{
+		TestFiles:  []string{testTestFiles},
+		FileSystem: fstest.MapFS{},
+	}
+
+	_, _, err := runner.LoadV3TestSuites("charts/missing")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+}
+
+func TestV3RunnerOkPackagedChartWithFileSystem(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	runner := TestRunner{
+		Printer:     printer.NewPrinter(buffer, nil),
+		TestFiles:   []string{"../../external/tests/ns2_test.yaml"},
+		ValuesFiles: []string{"external/ns_values.yaml"},
+		FileSystem:  os.DirFS("../../test/data"),
+	}
+	passed := runner.RunV3([]string{"v3/with-packaged-0.1.0.tgz"})
+	assert.True(t, passed, buffer.String())
+	assert.Contains(t, buffer.String(), "Test Suites: 1 passed, 1 total")
+}
+
+func TestV3RunnerOkWithFileSystemAssertionFiles(t *testing.T) {
+	suite := `
+suite: files of the assertions
+templates:
+  - configmap.yaml
+tests:
+  - it: should read the schema and policies from the file system
+    asserts:
+      - matchJsonSchema:
+          path: data
+          schemaFile: schemas/data.yaml
+      - matchPolicy:
+          policy: policies
+      - matchPolicy:
+          policy: /chart/tests/policies/level.rego
+`
+	schema := `
+type: object
+required: [level]
+properties:
+  level:
+    type: string
+`
+	policy := `
+package main
+
+deny contains msg if {
+	not input.data.level in data.levels
+	msg := sprintf("level %s is unknown", [input.data.level])
+}
+`
+	fsys := expectFailureChart(suite)
+	fsys["chart/tests/schemas/data.yaml"] = &fstest.MapFile{Data: []byte(schema)}
+	fsys["chart/tests/policies/level.rego"] = &fstest.MapFile{Data: []byte(policy)}
+	fsys["chart/tests/policies/data.json"] = &fstest.MapFile{Data: []byte(`{"levels": ["debug", "info"]}`)}
+
+	buffer := new(bytes.Buffer)
+	runner := TestRunner{
+		Printer:    printer.NewPrinter(buffer, nil),
+		TestFiles:  []string{testTestFiles},
+		FileSystem: fsys,
+	}
+
+	passed := runner.RunV3([]string{"chart"})
+	assert.True(t, passed, buffer.String())
+	assert.Contains(t, buffer.String(), "Tests:       1 passed, 1 total")
+}
+
+func TestV3RunnerWithFileSystemFailsOnDiskFiles(t *testing.T) {
+	tests := []struct {
+		name, test, expectedError string
+	}{
+		{
+			name: "exec assertion",
+			test: `
+    asserts:
+      - exec:
+          cmd: ./check.sh
+`,
+			expectedError: "command ./check.sh can not be run from the FileSystem, only commands in the PATH are supported with a FileSystem",
+		},
+		{
+			name: "post-renderer command",
+			test: `
+    postRenderer:
+      cmd: ./post-render.sh
+    asserts:
+      - isKind:
+          of: ConfigMap
+`,
+			expectedError: "post-renderer command ./post-render.sh can not be run from the FileSystem",
+		},
+		{
+			name: "post-renderer workingDir",
+			test: `
+    postRenderer:
+      cmd: cat
+      workingDir: kustomize
+    asserts:
+      - isKind:
+          of: ConfigMap
+`,
+			expectedError: "post-renderer workingDir is not supported with a FileSystem",
+		},
+		{
+			name: "kustomize post-renderer",
+			test: `
+    postRenderer:
+      kustomize:
+        dir: kustomize
+    asserts:
+      - isKind:
+          of: ConfigMap
+`,
+			expectedError: "kustomize post-renderer is not supported with a FileSystem, the kustomization kustomize is read from disk",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			suite := `
+suite: files on disk
+templates:
+  - configmap.yaml
+tests:
+  - it: should fail with a file system
+` + tt.test
+			buffer := new(bytes.Buffer)
+			runner := TestRunner{
+				Printer:    printer.NewPrinter(buffer, nil),
+				TestFiles:  []string{testTestFiles},
+				FileSystem: expectFailureChart(suite),
+			}
+
+			passed := runner.RunV3([]string{"chart"})
+			assert.False(t, passed, buffer.String())
+			assert.Contains(t, buffer.String(), tt.expectedError)
+		})
+	}
+}
+
+func expectFailureChart(suite string) fstest.MapFS {
+	chart := `
+apiVersion: v2
+name: expect-failure
+version: 0.1.0
+`
+	configMap := `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Release.Name }}
+data:
+  level: debug
+`
+	return fstest.MapFS{
+		"chart/Chart.yaml":                {Data: []byte(chart)},
+		"chart/templates/configmap.yaml":  {Data: []byte(configMap)},
+		"chart/tests/configmap_test.yaml": {Data: []byte(suite)},
+	}
+}
+
+func TestV3RunnerOkWithExpectedFailures(t *testing.T) {
+	suite := `
+suite: known bugs
+templates:
+  - configmap.yaml
+tests:
+  - it: should fail as expected
+    expectFailure:
+      reason: level is not configurable yet
+    asserts:
+      - equal:
+          path: data.level
+          value: info
+  - it: should pass with an assertion failing as expected
+    asserts:
+      - isKind:
+          of: ConfigMap
+      - equal:
+          path: data.level
+          value: warn
+        expectFailure:
+          reason: warn level is missing
+  - it: should pass
+    asserts:
+      - isKind:
+          of: ConfigMap
+`
+	buffer := new(bytes.Buffer)
+	runner := TestRunner{
+		Printer:    printer.NewPrinter(buffer, nil),
+		TestFiles:  []string{testTestFiles},
+		FileSystem: expectFailureChart(suite),
+	}
+
+	passed := runner.RunV3([]string{"chart"})
+	assert.True(t, passed, buffer.String())
+	assert.Contains(t, buffer.String(), "- XFAIL 'should fail as expected': level is not configurable yet")
+	assert.Contains(t, buffer.String(), "- XFAIL 'should pass with an assertion failing as expected': warn level is missing")
+	assert.Contains(t, buffer.String(), "Tests:       1 passed, 2 xfailed, 3 total")
+}
+
+func TestV3RunnerFailsWithUnexpectedPasses(t *testing.T) {
+	suite := `
+suite: fixed bugs
+templates:
+  - configmap.yaml
+tests:
+  - it: should have failed
+    expectFailure:
+      reason: level is not configurable yet
+    asserts:
+      - equal:
+          path: data.level
+          value: debug
+  - it: should have an assertion failing
+    asserts:
+      - equal:
+          path: data.level
+          value: debug
+        expectFailure:
+          reason: debug level is missing
+  - it: should have an assertion failing within a test expected to fail
+    expectFailure:
+      reason: level is not configurable yet
+    asserts:
+      - equal:
+          path: data.level
+          value: debug
+        expectFailure:
+          reason: debug level is missing
+      - equal:
+          path: data.level
+          value: info
+`
+	buffer := new(bytes.Buffer)
+	runner := TestRunner{
+		Printer:    printer.NewPrinter(buffer, nil),
+		TestFiles:  []string{testTestFiles},
+		FileSystem: expectFailureChart(suite),
+	}
+
+	passed := runner.RunV3([]string{"chart"})
+	assert.False(t, passed, buffer.String())
+	assert.Contains(t, buffer.String(), "- XPASS 'should have failed'")
+	assert.Contains(t, buffer.String(), "Expected to fail, but passed: level is not configurable yet")
+	assert.Contains(t, buffer.String(), "- asserts[0] `equal` XPASS")
+	assert.Contains(t, buffer.String(), "Expected to fail, but passed:")
+	assert.Contains(t, buffer.String(), "- should have an assertion failing within a test expected to fail")
+	assert.Contains(t, buffer.String(), "Tests:       3 failed, 0 passed, 3 total")
+}
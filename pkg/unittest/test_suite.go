@@ -4,6 +4,7 @@ import (
 	"cmp"
 	"errors"
 	"fmt"
+	"io/fs"
 	"maps"
 	"os"
 	"path/filepath"
@@ -16,7 +17,6 @@ import (
 	"github.com/helm-unittest/helm-unittest/pkg/unittest/results"
 	"github.com/helm-unittest/helm-unittest/pkg/unittest/snapshot"
 	v3chart "helm.sh/helm/v3/pkg/chart"
-	v3loader "helm.sh/helm/v3/pkg/chart/loader"
 	v3util "helm.sh/helm/v3/pkg/chartutil"
 	v3engine "helm.sh/helm/v3/pkg/engine"
 
@@ -29,7 +29,13 @@ var splitterPattern = regexp.MustCompile("(?:^|\\s*\n)---\\s*")
 
 // ParseTestSuiteFile parse a suite file that contain one or more suites at path and returns an array of TestSuite
 func ParseTestSuiteFile(suiteFilePath, chartRoute string, strict bool, valueFilesSet []string) ([]*TestSuite, error) {
-	content, err := os.ReadFile(suiteFilePath)
+	return ParseTestSuiteFS(nil, suiteFilePath, chartRoute, strict, valueFilesSet)
+}
+
+// ParseTestSuiteFS parse a suite file of fsys like ParseTestSuiteFile, the values files of the suites are read
+// from fsys as well. A nil fsys reads the files from the OS file system, like ParseTestSuiteFile.
+func ParseTestSuiteFS(fsys fs.FS, suiteFilePath, chartRoute string, strict bool, valueFilesSet []string) ([]*TestSuite, error) {
+	content, err := readFile(fsys, suiteFilePath)
 	if err != nil {
 		return []*TestSuite{{chartRoute: chartRoute}}, err
 	}
@@ -43,7 +49,7 @@ func ParseTestSuiteFile(suiteFilePath, chartRoute string, strict bool, valueFile
 	var testSuites []*TestSuite
 	for _, part := range parts {
 		if len(strings.TrimSpace(part)) > 0 {
-			testSuite, suiteErr := createTestSuite(suiteFilePath, chartRoute, part, strict, valueFilesSet, false)
+			testSuite, suiteErr := createTestSuite(fsys, suiteFilePath, chartRoute, part, strict, valueFilesSet, false)
 			if testSuite != nil {
 				for _, test := range testSuite.Tests {
 					if test != nil {
@@ -63,10 +69,11 @@ func ParseTestSuiteFile(suiteFilePath, chartRoute string, strict bool, valueFile
 	return testSuites, nil
 }
 
-func createTestSuite(suiteFilePath string, chartRoute string, content string, strict bool, valueFilesSet []string, fromRender bool) (*TestSuite, error) {
+func createTestSuite(fsys fs.FS, suiteFilePath string, chartRoute string, content string, strict bool, valueFilesSet []string, fromRender bool) (*TestSuite, error) {
 	suite := TestSuite{
 		chartRoute: chartRoute,
 		fromRender: fromRender,
+		fileSystem: fsys,
 	}
 
 	var err error
@@ -111,13 +118,18 @@ func createTestSuite(suiteFilePath string, chartRoute string, content string, st
 
 // RenderTestSuiteFiles renders a helm suite of test files and returns their TestSuites
 func RenderTestSuiteFiles(helmTestSuiteDir string, chartRoute string, strict bool, valueFilesSet []string, renderValues map[string]any) ([]*TestSuite, error) {
+	return renderTestSuiteFiles(nil, helmTestSuiteDir, chartRoute, strict, valueFilesSet, renderValues)
+}
+
+// renderTestSuiteFiles renders a helm suite of test files of fsys, or of the OS file system when fsys is nil
+func renderTestSuiteFiles(fsys fs.FS, helmTestSuiteDir string, chartRoute string, strict bool, valueFilesSet []string, renderValues map[string]any) ([]*TestSuite, error) {
 	testChartPath := filepath.Join(helmTestSuiteDir, "Chart.yaml")
 	// Ensure there's a helm file
-	if _, err := os.Stat(testChartPath); err != nil {
+	if _, err := statFile(fsys, testChartPath); err != nil {
 		return nil, err
 	}
 
-	chart, err := v3loader.Load(helmTestSuiteDir)
+	chart, err := loadChart(fsys, helmTestSuiteDir)
 	if err != nil {
 		return nil, err
 	}
@@ -144,7 +156,7 @@ func RenderTestSuiteFiles(helmTestSuiteDir string, chartRoute string, strict boo
 	// Filter any empty templates
 	// Set up a numerical snapshot idx if none provided
 	// Check that we didn't make a bunch of empty yamls
-	renderErrs, suites := iterateAllKeys(renderedFiles, chart.Name(), helmTestSuiteDir, chartRoute, strict, valueFilesSet)
+	renderErrs, suites := iterateAllKeys(fsys, renderedFiles, chart.Name(), helmTestSuiteDir, chartRoute, strict, valueFilesSet)
 
 	if len(renderErrs) > 0 {
 		return nil, errors.Join(renderErrs...)
@@ -153,7 +165,7 @@ func RenderTestSuiteFiles(helmTestSuiteDir string, chartRoute string, strict boo
 	return suites, nil
 }
 
-func iterateAllKeys(renderedFiles map[string]string, chartName, helmTestSuiteDir, chartRoute string, strict bool, valueFilesSet []string) ([]error, []*TestSuite) {
+func iterateAllKeys(fsys fs.FS, renderedFiles map[string]string, chartName, helmTestSuiteDir, chartRoute string, strict bool, valueFilesSet []string) ([]error, []*TestSuite) {
 	renderErrs := make([]error, 0)
 	suites := make([]*TestSuite, 0)
 
@@ -168,7 +180,7 @@ func iterateAllKeys(renderedFiles map[string]string, chartName, helmTestSuiteDir
 
 		var subYamlErrs []error
 		var previousSuitesLen int
-		subYamlErrs, previousSuitesLen, suites = iterateTemplates(template, suites, absPath, chartRoute, strict, valueFilesSet)
+		subYamlErrs, previousSuitesLen, suites = iterateTemplates(fsys, template, suites, absPath, chartRoute, strict, valueFilesSet)
 		if len(subYamlErrs) > 0 {
 			renderErrs = append(renderErrs, fmt.Errorf("test suite template (%s) error: %w", templateName, errors.Join(subYamlErrs...)))
 		}
@@ -180,7 +192,7 @@ func iterateAllKeys(renderedFiles map[string]string, chartName, helmTestSuiteDir
 	return renderErrs, suites
 }
 
-func iterateTemplates(template string, suites []*TestSuite, absPath string, chartRoute string, strict bool, valueFilesSet []string) ([]error, int, []*TestSuite) {
+func iterateTemplates(fsys fs.FS, template string, suites []*TestSuite, absPath string, chartRoute string, strict bool, valueFilesSet []string) ([]error, int, []*TestSuite) {
 	var subYamlErrs []error
 	templates := splitterPattern.Split(template, -1)
 	previousSuitesLen := len(suites)
@@ -191,7 +203,7 @@ func iterateTemplates(template string, suites []*TestSuite, absPath string, char
 		}
 		realIdx++
 
-		suite, err := createTestSuite(absPath, chartRoute, subYaml, strict, valueFilesSet, true)
+		suite, err := createTestSuite(fsys, absPath, chartRoute, subYaml, strict, valueFilesSet, true)
 		if err != nil {
 			subYamlErrs = append(subYamlErrs, fmt.Errorf("chart %d error: %w", idx, err))
 			continue
@@ -230,6 +242,10 @@ type TestSuite struct {
 	}
 	KubernetesProvider KubernetesFakeClientProvider `yaml:"kubernetesProvider"`
 	PostRendererConfig PostRendererConfig           `yaml:"postRenderer"`
+	PostRenderers      []PostRendererConfig         `yaml:"postRenderers"`
+	Hooks              string                       `yaml:"hooks"`
+	Timeout            string                       `yaml:"timeout"`
+	RawExtensions      []string                     `yaml:"rawExtensions"`
 
 	Tests []*TestJob
 	// where the test suite file located
@@ -241,6 +257,12 @@ type TestSuite struct {
 	fromRender bool
 	// if true, skip values.schema.json validation when rendering
 	skipSchemaValidation bool
+	// if true, the suite settings are already applied to the test jobs
+	polished bool
+	// the listeners notified of the finished test jobs
+	listeners runListeners
+	// the file system the values files are read from, the OS file system when nil
+	fileSystem fs.FS
 	// An identifier to append to snapshot files
 	SnapshotId string `yaml:"snapshotId"`
 	Skip       struct {
@@ -282,6 +304,11 @@ func (s *TestSuite) RunV3(
 
 // fill file path related info of TestJob
 func (s *TestSuite) polishTestJobsPathInfo() {
+	if s.polished {
+		return
+	}
+	s.polished = true
+
 	log.WithField(common.LOG_TEST_SUITE, "polish-test-jobs-path-info").Debug("suite '", s.Name, "' total tests ", len(s.Tests))
 	for _, test := range s.Tests {
 		if test != nil {
@@ -293,6 +320,11 @@ func (s *TestSuite) polishTestJobsPathInfo() {
 			s.polishKubernetesProviderSettings(test)
 			s.polishChartSettings(test)
 			s.polishSkipSettings(test)
+			test.Hooks = cmp.Or(test.Hooks, s.Hooks)
+			test.Timeout = cmp.Or(test.Timeout, s.Timeout)
+			if len(test.RawExtensions) == 0 {
+				test.RawExtensions = s.RawExtensions
+			}
 
 			// Make deep clone of global set
 			test.globalSet = CopySet(s.Set)
@@ -394,30 +426,16 @@ func (s *TestSuite) runV3TestJobs(
 	skipped := 0
 
 	for idx, testJob := range s.Tests {
-		// Deep clone of chart
-		chartClone := FullCopyV3Chart(s.chartRoute, chart.Name(), chart)
-
-		var jobResult *results.TestJobResult
-		job := results.TestJobResult{DisplayName: testJob.Name, Index: idx}
+		jobResult := s.runV3TestJob(chart, cache, idx, failFast, renderPath)
+		jobResults[idx] = jobResult
+		s.listeners.notifyJobFinished(s, jobResult)
 
 		if testJob.Skip.Reason != "" {
-			job.Skipped = true
 			skipped++
-			jobResults[idx] = &job
 			if idx == 0 {
 				result.Pass = true
 			}
 		} else {
-			testJob.WithConfig(*NewTestConfig(chartClone, cache,
-				WithRenderPath(renderPath),
-				WithFailFast(failFast),
-				WithPostRendererConfig(s.PostRendererConfig),
-				WithDocumentSelector(testJob.DocumentSelector),
-				WithIncludeCrds(s.IncludeCrds),
-				WithSkipSchemaValidation(s.skipSchemaValidation),
-			))
-			jobResult = testJob.RunV3(&job)
-			jobResults[idx] = jobResult
 			if idx == 0 {
 				result.Pass = jobResult.Passed
 			}
@@ -434,6 +452,50 @@ func (s *TestSuite) runV3TestJobs(
 	return &result
 }
 
+// RunV3Job runs the test job at the index of Tests, to run the test jobs of the TestSuite one by one.
+// The snapshotCache is shared by the test jobs of the TestSuite.
+func (s *TestSuite) RunV3Job(
+	chart *v3chart.Chart,
+	snapshotCache *snapshot.Cache,
+	index int,
+	failFast bool,
+	renderPath string,
+) *results.TestJobResult {
+	s.polishTestJobsPathInfo()
+	return s.runV3TestJob(chart, snapshotCache, index, failFast, renderPath)
+}
+
+// runV3TestJob runs the test job at the index of Tests, skipped test jobs are not rendered.
+func (s *TestSuite) runV3TestJob(
+	chart *v3chart.Chart,
+	cache *snapshot.Cache,
+	index int,
+	failFast bool,
+	renderPath string,
+) *results.TestJobResult {
+	testJob := s.Tests[index]
+	job := results.TestJobResult{DisplayName: testJob.Name, Description: testJob.description(), Index: index}
+
+	if testJob.Skip.Reason != "" {
+		job.Skipped = true
+		return &job
+	}
+
+	// Deep clone of chart
+	chartClone := FullCopyV3Chart(s.chartRoute, chart.Name(), chart)
+	testJob.WithConfig(*NewTestConfig(chartClone, cache,
+		WithRenderPath(renderPath),
+		WithFailFast(failFast),
+		WithPostRendererConfig(s.PostRendererConfig),
+		WithPostRenderers(s.PostRenderers),
+		WithDocumentSelector(testJob.DocumentSelector),
+		WithIncludeCrds(s.IncludeCrds),
+		WithSkipSchemaValidation(s.skipSchemaValidation),
+		WithFileSystem(s.fileSystem),
+	))
+	return testJob.RunV3(&job)
+}
+
 // VersionMeetsMinimum check if currentVersion meets the minimumVersion requirement
 func VersionMeetsMinimum(currentVersion, minimumVersion string) bool {
 	current, err := semver.NewVersion(currentVersion)
@@ -478,6 +540,11 @@ func (s *TestSuite) validateTestSuite() error {
 	return nil
 }
 
+// DefinitionFile returns the path of the file the TestSuite is defined in.
+func (s *TestSuite) DefinitionFile() string {
+	return s.definitionFile
+}
+
 func (s *TestSuite) SnapshotFileUrl() string {
 	if len(s.SnapshotId) > 0 {
 		// append the snapshot id
@@ -598,6 +598,49 @@ tests:
 	validateTestResultAndSnapshots(t, suiteResult, false, "test suite name", 1, 0, 0, 0, 0)
 }
 
+func TestV3RunSuiteJobOneByOne(t *testing.T) {
+	suiteDoc := `
+suite: test suite name
+templates:
+  - configmap.yaml
+  - deployment.yaml
+values:
+  - ` + testValuesFiles + `
+tests:
+  - it: should be skipped
+    skip:
+      reason: not needed
+    asserts:
+      - equal:
+          path: kind
+          value: Pod
+  - it: should pass
+    template: deployment.yaml
+    asserts:
+      - equal:
+          path: kind
+          value: Deployment
+`
+	testSuite := TestSuite{}
+	common.YmlUnmarshalTestHelper(suiteDoc, &testSuite, t)
+	chart, chartErr := v3loader.Load(testV3BasicChart)
+	assert.NoError(t, chartErr)
+
+	cache, _ := snapshot.CreateSnapshotOfSuite(path.Join(tmpdir, "v3_suite_job_test.yaml"), false)
+
+	skippedResult := testSuite.RunV3Job(chart, cache, 0, false, "")
+	assert.True(t, skippedResult.Skipped)
+	assert.Equal(t, "should be skipped", skippedResult.DisplayName)
+
+	for range 2 {
+		jobResult := testSuite.RunV3Job(chart, cache, 1, false, "")
+		assert.True(t, jobResult.Passed, jobResult.Stringify())
+		assert.Equal(t, 1, jobResult.Index)
+		// the suite settings are applied to the test jobs once
+		assert.Len(t, testSuite.Tests[1].Values, 1)
+	}
+}
+
 func TestV3RunSuiteWithSubfolderWhenPass(t *testing.T) {
 	suiteDoc := `
 suite: test suite name
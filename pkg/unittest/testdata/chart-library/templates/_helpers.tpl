@@ -0,0 +1,12 @@
+{{- define "library.name" -}}
+{{- default .Chart.Name .Values.nameOverride | trunc 63 | trimSuffix "-" -}}
+{{- end -}}
+
+{{- define "library.fullname" -}}
+{{- printf "%s-%s" .Release.Name (include "library.name" .) | trunc 63 | trimSuffix "-" -}}
+{{- end -}}
+
+{{- define "library.labels" -}}
+app.kubernetes.io/name: {{ include "library.name" .context }}
+app.kubernetes.io/component: {{ .component }}
+{{- end -}}
@@ -0,0 +1,15 @@
+package main
+
+import rego.v1
+
+deny contains msg if {
+	some container in input.spec.template.spec.containers
+	container.securityContext.privileged
+	msg := sprintf("container '%s' must not be privileged", [container.name])
+}
+
+deny_latest contains msg if {
+	some container in input.spec.template.spec.containers
+	endswith(container.image, ":latest")
+	msg := sprintf("container '%s' must not use the latest tag", [container.name])
+}
@@ -0,0 +1,9 @@
+package release
+
+import rego.v1
+
+violation contains {"msg": msg} if {
+	some doc in input
+	doc.metadata.labels.team == ""
+	msg := sprintf("%s '%s' must have a team label", [doc.kind, doc.metadata.name])
+}
@@ -0,0 +1,215 @@
+// Package unittesttest runs the test suites of charts as subtests of a Go test,
+// so the chart tests run within `go test`, with `-run` filtering and the Go test tooling.
+//
+//	func TestChart(t *testing.T) {
+//		unittesttest.RunChart(t, "../charts/my-chart")
+//	}
+//
+// Each TestSuite runs as a subtest of the test, and each TestJob as a subtest of its TestSuite.
+// The failure information of the failed assertions is reported as errors of the TestJob subtest.
+package unittesttest
+
+import (
+	"cmp"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/helm-unittest/helm-unittest/pkg/unittest"
+	"github.com/helm-unittest/helm-unittest/pkg/unittest/results"
+	"github.com/helm-unittest/helm-unittest/pkg/unittest/snapshot"
+	v3chart "helm.sh/helm/v3/pkg/chart"
+)
+
+// DefaultTestFiles the glob path of the test suite files in the chart, the same as the plugin default.
+var DefaultTestFiles = filepath.Join("tests", "*_test.yaml")
+
+// RunOptionsFunc sets an option of the TestRunner which loads and runs the test suites.
+type RunOptionsFunc func(*unittest.TestRunner)
+
+// WithTestFiles sets the glob paths of the test suite files, relative to the chart, default to DefaultTestFiles.
+func WithTestFiles(testFiles ...string) RunOptionsFunc {
+	return func(tr *unittest.TestRunner) {
+		tr.TestFiles = testFiles
+	}
+}
+
+// WithValuesFiles sets the values files which override the values of the chart.
+func WithValuesFiles(valuesFiles ...string) RunOptionsFunc {
+	return func(tr *unittest.TestRunner) {
+		tr.ValuesFiles = valuesFiles
+	}
+}
+
+// WithSubChart sets whether the test suites of the subcharts are run, default to true.
+func WithSubChart(withSubChart bool) RunOptionsFunc {
+	return func(tr *unittest.TestRunner) {
+		tr.WithSubChart = withSubChart
+	}
+}
+
+// WithStrict sets whether the test suites are parsed strictly.
+func WithStrict(strict bool) RunOptionsFunc {
+	return func(tr *unittest.TestRunner) {
+		tr.Strict = strict
+	}
+}
+
+// WithUpdateSnapshot sets whether the snapshots are updated instead of compared.
+func WithUpdateSnapshot(updateSnapshot bool) RunOptionsFunc {
+	return func(tr *unittest.TestRunner) {
+		tr.UpdateSnapshot = updateSnapshot
+	}
+}
+
+// WithSkipSchemaValidation sets whether the values schema validation is skipped when rendering.
+func WithSkipSchemaValidation(skip bool) RunOptionsFunc {
+	return func(tr *unittest.TestRunner) {
+		tr.SkipSchemaValidation = skip
+	}
+}
+
+// WithChartTestsPath sets the folder of the chart which is rendered into test suites, relative to the chart.
+func WithChartTestsPath(chartTestsPath string) RunOptionsFunc {
+	return func(tr *unittest.TestRunner) {
+		tr.ChartTestsPath = chartTestsPath
+	}
+}
+
+// WithFileSystem sets the file system the chart, the test suites and the values files are read from,
+// the snapshots are compared with the snapshot files of fsys and written to a snapshot.MemoryStore.
+func WithFileSystem(fsys fs.FS) RunOptionsFunc {
+	return func(tr *unittest.TestRunner) {
+		tr.FileSystem = fsys
+	}
+}
+
+// newTestRunner creates the TestRunner with the plugin defaults and the options.
+func newTestRunner(options ...RunOptionsFunc) *unittest.TestRunner {
+	runner := &unittest.TestRunner{
+		WithSubChart: true,
+		TestFiles:    []string{DefaultTestFiles},
+	}
+	for _, option := range options {
+		option(runner)
+	}
+	return runner
+}
+
+// RunChart runs the test suites of the chart in chartPath as subtests of t.
+func RunChart(t *testing.T, chartPath string, options ...RunOptionsFunc) {
+	t.Helper()
+	runTestSuites(t, chartPath, newTestRunner(options...))
+}
+
+// RunSuiteFile runs the test suites of the suiteFile as subtests of t,
+// the suiteFile is relative to the chart in chartPath, like the plugin `--file` flag.
+func RunSuiteFile(t *testing.T, chartPath, suiteFile string, options ...RunOptionsFunc) {
+	t.Helper()
+	runner := newTestRunner(options...)
+	runner.TestFiles = []string{suiteFile}
+	runner.WithSubChart = false
+	runTestSuites(t, chartPath, runner)
+}
+
+// runTestSuites loads the test suites of the chart and runs each TestSuite as subtest.
+func runTestSuites(t *testing.T, chartPath string, runner *unittest.TestRunner) {
+	t.Helper()
+
+	chart, suites, loaded := loadTestSuites(t, chartPath, runner)
+	if !loaded {
+		return
+	}
+
+	for _, suite := range suites {
+		t.Run(cmp.Or(suite.Name, filepath.Base(suite.DefinitionFile())), func(t *testing.T) {
+			runTestSuite(t, chart, suite, runner)
+		})
+	}
+}
+
+// loadTestSuites loads the chart and its test suites, an error loading them or no test suites
+// are reported as error of t.
+func loadTestSuites(t testing.TB, chartPath string, runner *unittest.TestRunner) (*v3chart.Chart, []*unittest.TestSuite, bool) {
+	t.Helper()
+
+	chart, suites, err := runner.LoadV3TestSuites(chartPath)
+	if err != nil {
+		t.Errorf("can not load the test suites of chart %s: %s", chartPath, err)
+		return nil, nil, false
+	}
+	if len(suites) == 0 {
+		t.Errorf("no test suites found in chart %s matching %s", chartPath, strings.Join(runner.TestFiles, ", "))
+		return nil, nil, false
+	}
+	return chart, suites, true
+}
+
+// runTestSuite runs each TestJob of the TestSuite as subtest, the results are collected in the suite result.
+func runTestSuite(t *testing.T, chart *v3chart.Chart, suite *unittest.TestSuite, runner *unittest.TestRunner) {
+	snapshotCache, err := runner.SnapshotCacheOfSuite(suite)
+	if err != nil {
+		t.Fatalf("can not load the snapshots of %s: %s", suite.SnapshotFileUrl(), err)
+	}
+
+	suiteResult := &results.TestSuiteResult{DisplayName: suite.Name, FilePath: suite.DefinitionFile()}
+	for index, testJob := range suite.Tests {
+		t.Run(testJob.Name, func(t *testing.T) {
+			result := suite.RunV3Job(chart, snapshotCache, index, runner.Failfast, runner.RenderPath)
+			suiteResult.TestsResult = append(suiteResult.TestsResult, result)
+			reportTestJobResult(t, result, testJob.Skip.Reason)
+		})
+	}
+
+	storeSnapshots(t, suite, suiteResult, snapshotCache)
+}
+
+// storeSnapshots stores the snapshots when the suite result has the results of all test jobs,
+// so the snapshots of the test jobs left out by `-run` are kept.
+func storeSnapshots(t testing.TB, suite *unittest.TestSuite, suiteResult *results.TestSuiteResult, snapshotCache *snapshot.Cache) {
+	t.Helper()
+
+	if len(suiteResult.TestsResult) < len(suite.Tests) {
+		return
+	}
+	if _, err := snapshotCache.StoreToFileIfNeeded(); err != nil {
+		t.Errorf("can not store the snapshots of %s: %s", suite.SnapshotFileUrl(), err)
+	}
+}
+
+// reportTestJobResult reports the execution error and the failure information of the failed assertions,
+// skipped test jobs and expected failures are reported as skipped with the reason.
+func reportTestJobResult(t testing.TB, result *results.TestJobResult, skipReason string) {
+	t.Helper()
+
+	if result.Skipped {
+		t.Skip(skipReason)
+		return
+	}
+	if result.ExpectedFailure {
+		t.Skip(result.ExpectedFailureMessage())
+		return
+	}
+
+	if result.UnexpectedPass {
+		t.Errorf("%s", result.FailureMessage())
+		return
+	}
+
+	if result.ExecError != nil {
+		t.Errorf("Error: %s", result.ExecError)
+		return
+	}
+
+	for _, assertResult := range result.AssertsResult {
+		if assertResult == nil || assertResult.Passed || assertResult.Skipped {
+			continue
+		}
+		t.Errorf("%s\n%s", assertResult.Title(), strings.Join(assertResult.FailInfo, "\n"))
+	}
+
+	if !result.Passed && !t.Failed() {
+		t.Errorf("- %s failed", result.DisplayName)
+	}
+}
@@ -0,0 +1,132 @@
+package unittesttest
+
+import (
+	"fmt"
+	"testing"
+	"testing/fstest"
+
+	"github.com/helm-unittest/helm-unittest/pkg/unittest/results"
+	"github.com/helm-unittest/helm-unittest/pkg/unittest/snapshot"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeTB records the errors and skips reported to it, instead of failing the test.
+type fakeTB struct {
+	testing.TB
+	errors  []string
+	skipped []string
+}
+
+func (f *fakeTB) Helper() {}
+
+func (f *fakeTB) Errorf(format string, args ...any) {
+	f.errors = append(f.errors, fmt.Sprintf(format, args...))
+}
+
+func (f *fakeTB) Failed() bool {
+	return len(f.errors) > 0
+}
+
+func (f *fakeTB) Skip(args ...any) {
+	f.skipped = append(f.skipped, fmt.Sprint(args...))
+}
+
+func chartFileSystem(suite string) fstest.MapFS {
+	return fstest.MapFS{
+		"chart/Chart.yaml":                {Data: []byte("apiVersion: v2\nname: fake\nversion: 0.1.0\n")},
+		"chart/templates/configmap.yaml":  {Data: []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: config\n")},
+		"chart/tests/configmap_test.yaml": {Data: []byte(suite)},
+	}
+}
+
+func TestLoadTestSuitesReportsLoadError(t *testing.T) {
+	fake := &fakeTB{}
+	runner := newTestRunner(WithFileSystem(fstest.MapFS{}))
+
+	_, _, loaded := loadTestSuites(fake, "missing", runner)
+
+	assert.False(t, loaded)
+	assert.Len(t, fake.errors, 1)
+	assert.Contains(t, fake.errors[0], "can not load the test suites of chart missing: ")
+}
+
+func TestLoadTestSuitesReportsNoTestSuites(t *testing.T) {
+	fake := &fakeTB{}
+	runner := newTestRunner(WithFileSystem(chartFileSystem("")), WithTestFiles("tests/none_test.yaml"))
+
+	_, _, loaded := loadTestSuites(fake, "chart", runner)
+
+	assert.False(t, loaded)
+	assert.Equal(t, []string{"no test suites found in chart chart matching tests/none_test.yaml"}, fake.errors)
+}
+
+func TestReportTestJobResultReportsFailingAssertion(t *testing.T) {
+	runner := newTestRunner(WithFileSystem(chartFileSystem(`
+suite: configmap
+templates:
+  - configmap.yaml
+tests:
+  - it: should fail
+    asserts:
+      - equal:
+          path: metadata.name
+          value: custom
+`)))
+	chart, suites, loaded := loadTestSuites(t, "chart", runner)
+	assert.True(t, loaded)
+	snapshotCache, err := runner.SnapshotCacheOfSuite(suites[0])
+	assert.NoError(t, err)
+
+	fake := &fakeTB{}
+	result := suites[0].RunV3Job(chart, snapshotCache, 0, false, "")
+	reportTestJobResult(fake, result, "")
+
+	assert.False(t, result.Passed)
+	assert.Empty(t, fake.skipped)
+	assert.Len(t, fake.errors, 1)
+	assert.Contains(t, fake.errors[0], "asserts[0] `equal` fail")
+	assert.Contains(t, fake.errors[0], "custom")
+}
+
+func TestReportTestJobResultReportsExecError(t *testing.T) {
+	fake := &fakeTB{}
+
+	reportTestJobResult(fake, &results.TestJobResult{DisplayName: "broken", ExecError: fmt.Errorf("parse error")}, "")
+
+	assert.Equal(t, []string{"Error: parse error"}, fake.errors)
+}
+
+func TestStoreSnapshotsWhenSuiteResultComplete(t *testing.T) {
+	fsys := chartFileSystem(`
+suite: configmap
+templates:
+  - configmap.yaml
+tests:
+  - it: should match the snapshot
+    asserts:
+      - matchSnapshot: {}
+  - it: should be a config map
+    asserts:
+      - isKind:
+          of: ConfigMap
+`)
+	store := snapshot.NewMemoryStore(fsys)
+	runner := newTestRunner(WithFileSystem(fsys))
+	runner.SnapshotStore = store
+	chart, suites, loaded := loadTestSuites(t, "chart", runner)
+	assert.True(t, loaded)
+	suite := suites[0]
+	snapshotCache, err := runner.SnapshotCacheOfSuite(suite)
+	assert.NoError(t, err)
+
+	fake := &fakeTB{}
+	suiteResult := &results.TestSuiteResult{}
+	suiteResult.TestsResult = append(suiteResult.TestsResult, suite.RunV3Job(chart, snapshotCache, 0, false, ""))
+	storeSnapshots(fake, suite, suiteResult, snapshotCache)
+	assert.Empty(t, store.Files(), "the snapshots are kept while test jobs did not run")
+
+	suiteResult.TestsResult = append(suiteResult.TestsResult, suite.RunV3Job(chart, snapshotCache, 1, false, ""))
+	storeSnapshots(fake, suite, suiteResult, snapshotCache)
+	assert.Len(t, store.Files(), 1)
+	assert.Empty(t, fake.errors)
+}
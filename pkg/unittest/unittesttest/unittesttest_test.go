@@ -0,0 +1,52 @@
+package unittesttest_test
+
+import (
+	"os"
+	"testing"
+	"testing/fstest"
+
+	. "github.com/helm-unittest/helm-unittest/pkg/unittest/unittesttest"
+)
+
+const testV3BasicChart = "../../../test/data/v3/basic"
+
+func TestRunChart(t *testing.T) {
+	RunChart(t, testV3BasicChart)
+}
+
+func TestRunSuiteFile(t *testing.T) {
+	RunSuiteFile(t, testV3BasicChart, "tests/deployment_test.yaml", WithStrict(false))
+}
+
+func TestRunChartWithOptions(t *testing.T) {
+	RunChart(t, testV3BasicChart,
+		WithTestFiles("tests/service_test.yaml", "tests/configmap_test.yaml"),
+		WithSubChart(false),
+		WithSkipSchemaValidation(true),
+	)
+}
+
+func TestRunChartWithFileSystem(t *testing.T) {
+	RunChart(t, ".", WithFileSystem(os.DirFS(testV3BasicChart)))
+}
+
+func TestRunChartWithExpectedFailure(t *testing.T) {
+	fsys := fstest.MapFS{
+		"chart/Chart.yaml":               {Data: []byte("apiVersion: v2\nname: expect-failure\nversion: 0.1.0\n")},
+		"chart/templates/configmap.yaml": {Data: []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: config\n")},
+		"chart/tests/configmap_test.yaml": {Data: []byte(`
+suite: known bugs
+templates:
+  - configmap.yaml
+tests:
+  - it: should fail as expected
+    expectFailure:
+      reason: the name is not configurable yet
+    asserts:
+      - equal:
+          path: metadata.name
+          value: custom
+`)},
+	}
+	RunChart(t, "chart", WithFileSystem(fsys))
+}
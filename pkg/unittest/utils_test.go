@@ -448,3 +448,75 @@ Expected pattern '.*not-in-snapshot.*' not found in snapshot:
 	assert.Contains(t, buffer.String(), "Tests:       1 failed, 0 passed, 1 total")
 	assert.Contains(t, buffer.String(), "Snapshot:    1 passed, 1 total")
 }
+
+func TestV3RunnerWith_Fixture_Chart_ReleaseScope(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	runner := TestRunner{
+		Printer:   printer.NewPrinter(buffer, nil),
+		TestFiles: []string{"tests/*_test.yaml"},
+		Strict:    true,
+	}
+	passed := runner.RunV3([]string{"testdata/chart-release-scope"})
+	assert.True(t, passed, buffer.String())
+	assert.Contains(t, buffer.String(), "Tests:       2 passed, 2 total")
+}
+
+func TestV3RunnerWith_Fixture_Chart_References(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	runner := TestRunner{
+		Printer:   printer.NewPrinter(buffer, nil),
+		TestFiles: []string{"tests/*_test.yaml"},
+		Strict:    true,
+	}
+	passed := runner.RunV3([]string{"testdata/chart-references"})
+	assert.True(t, passed, buffer.String())
+	assert.Contains(t, buffer.String(), "Tests:       4 passed, 4 total")
+}
+
+func TestV3RunnerWith_Fixture_Chart_Policy(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	runner := TestRunner{
+		Printer:   printer.NewPrinter(buffer, nil),
+		TestFiles: []string{"tests/*_test.yaml"},
+		Strict:    true,
+	}
+	passed := runner.RunV3([]string{"testdata/chart-policy"})
+	assert.True(t, passed, buffer.String())
+	assert.Contains(t, buffer.String(), "Tests:       6 passed, 6 total")
+}
+
+func TestV3RunnerWith_Fixture_Chart_Hooks(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	runner := TestRunner{
+		Printer:   printer.NewPrinter(buffer, nil),
+		TestFiles: []string{"tests/*_test.yaml"},
+		Strict:    true,
+	}
+	passed := runner.RunV3([]string{"testdata/chart-hooks"})
+	assert.True(t, passed, buffer.String())
+	assert.Contains(t, buffer.String(), "Tests:       4 passed, 4 total")
+}
+
+func TestV3RunnerWith_Fixture_Chart_TextOutputs(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	runner := TestRunner{
+		Printer:   printer.NewPrinter(buffer, nil),
+		TestFiles: []string{"tests/*_test.yaml"},
+		Strict:    true,
+	}
+	passed := runner.RunV3([]string{"testdata/chart-text-outputs"})
+	assert.True(t, passed, buffer.String())
+	assert.Contains(t, buffer.String(), "Tests:       4 passed, 4 total")
+}
+
+func TestV3RunnerWith_Fixture_Chart_Library(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	runner := TestRunner{
+		Printer:   printer.NewPrinter(buffer, nil),
+		TestFiles: []string{"tests/*_test.yaml"},
+		Strict:    true,
+	}
+	passed := runner.RunV3([]string{"testdata/chart-library"})
+	assert.True(t, passed, buffer.String())
+	assert.Contains(t, buffer.String(), "Tests:       4 passed, 4 total")
+}
@@ -0,0 +1,179 @@
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+	. "github.com/helm-unittest/helm-unittest/pkg/unittest/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+var baselineDocsToTestDiff = []common.K8sManifest{
+	makeManifest(`
+kind: Deployment
+metadata:
+  name: foo
+spec:
+  replicas: 1
+  template:
+    spec:
+      containers:
+        - name: foo
+          image: foo:1.0
+`),
+	makeManifest(`
+kind: Service
+metadata:
+  name: foo
+spec:
+  ports:
+    - port: 80
+`),
+	makeManifest(`
+kind: ConfigMap
+metadata:
+  name: bar
+  namespace: baz
+`),
+}
+
+var docsToTestDiff = []common.K8sManifest{
+	makeManifest(`
+kind: Deployment
+metadata:
+  name: foo
+spec:
+  replicas: 2
+  template:
+    spec:
+      containers:
+        - name: foo
+          image: foo:1.0
+`),
+	makeManifest(`
+kind: Service
+metadata:
+  name: foo
+spec:
+  ports:
+    - port: 80
+`),
+	makeManifest(`
+kind: NetworkPolicy
+metadata:
+  name: foo
+`),
+}
+
+func TestAddedDocumentsValidatorWhenOk(t *testing.T) {
+	validator := AddedDocumentsValidator{Resources: []string{"NetworkPolicy/foo"}}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:         docsToTestDiff,
+		BaselineDocs: &baselineDocsToTestDiff,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestAddedDocumentsValidatorWithCountWhenOk(t *testing.T) {
+	count := 1
+	validator := AddedDocumentsValidator{Resources: []string{"NetworkPolicy"}, Count: &count}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:         docsToTestDiff,
+		BaselineDocs: &baselineDocsToTestDiff,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestAddedDocumentsValidatorWithoutResourcesWhenOk(t *testing.T) {
+	validator := AddedDocumentsValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:         docsToTestDiff,
+		BaselineDocs: &baselineDocsToTestDiff,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestAddedDocumentsValidatorWhenNegativeAndOk(t *testing.T) {
+	validator := AddedDocumentsValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:         baselineDocsToTestDiff,
+		BaselineDocs: &baselineDocsToTestDiff,
+		Negative:     true,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestAddedDocumentsValidatorWithReleaseNamespaceWhenNegativeAndOk(t *testing.T) {
+	docs := []common.K8sManifest{
+		makeManifest(`
+kind: ConfigMap
+metadata:
+  name: bar
+`),
+	}
+	validator := AddedDocumentsValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:          docs,
+		BaselineDocs:  &[]common.K8sManifest{baselineDocsToTestDiff[2]},
+		RenderContext: RenderContext{Release: map[string]any{"Namespace": "baz"}},
+		Negative:      true,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestAddedDocumentsValidatorWhenFail(t *testing.T) {
+	validator := AddedDocumentsValidator{Resources: []string{"NetworkPolicy", "Ingress"}}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:         docsToTestDiff,
+		BaselineDocs: &baselineDocsToTestDiff,
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Expected added documents:",
+		"	NetworkPolicy",
+		"	Ingress",
+		"Actual:",
+		"	NetworkPolicy/foo",
+	}, diff)
+}
+
+func TestAddedDocumentsValidatorWithCountWhenFail(t *testing.T) {
+	count := 0
+	validator := AddedDocumentsValidator{Count: &count}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:         docsToTestDiff,
+		BaselineDocs: &baselineDocsToTestDiff,
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Expected added documents:",
+		"	count: 0",
+		"Actual:",
+		"	NetworkPolicy/foo",
+	}, diff)
+}
+
+func TestAddedDocumentsValidatorWithoutBaselineFail(t *testing.T) {
+	validator := AddedDocumentsValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: docsToTestDiff,
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Error:",
+		"	expected `diffFrom` to be set on the test, to render the baseline",
+	}, diff)
+}
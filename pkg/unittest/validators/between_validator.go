@@ -0,0 +1,27 @@
+package validators
+
+// BetweenValidator validate whether the value of Path is between Min and Max, including both.
+// The values are compared as quantities or durations when CompareAs is set to quantity or duration.
+type BetweenValidator struct {
+	Path      string
+	Min       any
+	Max       any
+	CompareAs string
+}
+
+// Validate implement Validatable
+func (b BetweenValidator) Validate(context *ValidateContext) (bool, []string) {
+	if b.Min == nil || b.Max == nil {
+		return false, splitInfof(errorFormat, -1, -1, "expected field 'min' and 'max' to be filled")
+	}
+
+	operatorValidator := operatorValidator{
+		Path:           b.Path,
+		Value:          b.Min,
+		Max:            b.Max,
+		ComparisonType: "between",
+		CompareAs:      b.CompareAs,
+	}
+
+	return operatorValidator.Validate(context)
+}
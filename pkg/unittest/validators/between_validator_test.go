@@ -0,0 +1,159 @@
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+	. "github.com/helm-unittest/helm-unittest/pkg/unittest/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+var docToTestBetween = `
+spec:
+  replicas: 3
+  containers:
+    - resources:
+        requests:
+          cpu: 250m
+          memory: 256Mi
+  terminationGracePeriod: 30s
+`
+
+func TestBetweenValidatorWhenOk(t *testing.T) {
+	tests := []struct {
+		name, path, compareAs string
+		min, max              any
+	}{
+		{name: "int", path: "spec.replicas", min: 2, max: 3},
+		{name: "cpu quantity", path: "spec.containers[0].resources.requests.cpu", compareAs: "quantity", min: "100m", max: 1},
+		{name: "memory quantity", path: "spec.containers[0].resources.requests.memory", compareAs: "quantity", min: "128Mi", max: "1Gi"},
+		{name: "duration", path: "spec.terminationGracePeriod", compareAs: "duration", min: "10s", max: "1m"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := BetweenValidator{
+				Path:      tt.path,
+				Min:       tt.min,
+				Max:       tt.max,
+				CompareAs: tt.compareAs,
+			}
+			pass, diff := v.Validate(&ValidateContext{
+				Docs: []common.K8sManifest{makeManifest(docToTestBetween)},
+			})
+
+			assert.True(t, pass)
+			assert.Equal(t, []string{}, diff)
+		})
+	}
+}
+
+func TestBetweenValidatorWhenFail(t *testing.T) {
+	v := BetweenValidator{
+		Path:      "spec.containers[0].resources.requests.cpu",
+		Min:       "500m",
+		Max:       2,
+		CompareAs: "quantity",
+	}
+	pass, diff := v.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestBetween)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"DocumentIndex:\t0",
+		"ValuesIndex:\t0",
+		"Path:\tspec.containers[0].resources.requests.cpu",
+		"Expected to be between, got:",
+		"\tthe actual '250m' is not between '500m' and '2'",
+	}, diff)
+}
+
+func TestBetweenValidatorWhenNegativeAndOk(t *testing.T) {
+	v := BetweenValidator{
+		Path: "spec.replicas",
+		Min:  5,
+		Max:  10,
+	}
+	pass, diff := v.Validate(&ValidateContext{
+		Docs:     []common.K8sManifest{makeManifest(docToTestBetween)},
+		Negative: true,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestBetweenValidatorWhenNegativeAndFail(t *testing.T) {
+	v := BetweenValidator{
+		Path: "spec.replicas",
+		Min:  1,
+		Max:  3,
+	}
+	pass, diff := v.Validate(&ValidateContext{
+		Docs:     []common.K8sManifest{makeManifest(docToTestBetween)},
+		Negative: true,
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"DocumentIndex:\t0",
+		"ValuesIndex:\t0",
+		"Path:\tspec.replicas",
+		"Expected NOT to be between, got:",
+		"\tthe actual '3' is between '1' and '3'",
+	}, diff)
+}
+
+func TestBetweenValidatorWhenTypesDoNotMatch(t *testing.T) {
+	v := BetweenValidator{
+		Path: "spec.replicas",
+		Min:  "1",
+		Max:  "5",
+	}
+	pass, diff := v.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestBetween)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"DocumentIndex:\t0",
+		"ValuesIndex:\t0",
+		"Error:",
+		"\tactual 'int' and expected 'string' types do not match",
+	}, diff)
+}
+
+func TestBetweenValidatorWhenMinOrMaxMissing(t *testing.T) {
+	v := BetweenValidator{
+		Path: "spec.replicas",
+		Min:  1,
+	}
+	pass, diff := v.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestBetween)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Error:",
+		"\texpected field 'min' and 'max' to be filled",
+	}, diff)
+}
+
+func TestBetweenValidatorWhenNoManifestFail(t *testing.T) {
+	v := BetweenValidator{
+		Path: "spec.replicas",
+		Min:  1,
+		Max:  3,
+	}
+	pass, diff := v.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Path:\tspec.replicas",
+		"Expected to be between, got:",
+		"\tno manifests found",
+	}, diff)
+}
@@ -0,0 +1,91 @@
+package validators
+
+import (
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ChangedPathsValidator validate whether the values at the Paths changed compared to the baseline of `diffFrom`,
+// in the documents rendered in both, or only in the Resource, referenced as Kind, Kind/name or Kind/namespace/name.
+// Without Paths, the documents should be changed.
+type ChangedPathsValidator struct {
+	Resource string
+	Paths    []string
+}
+
+func (v ChangedPathsValidator) failInfo(changed []diffDocument, not bool) []string {
+	expected := "any paths"
+	if len(v.Paths) > 0 {
+		expected = strings.Join(v.Paths, "\n")
+	}
+	actualPaths := make([]string, 0)
+	for _, document := range changed {
+		for _, path := range document.paths {
+			actualPaths = append(actualPaths, fmt.Sprintf("%s: %s", document.identifier, path))
+		}
+	}
+	actual := strings.Join(actualPaths, "\n")
+	if actual == "" {
+		actual = "no changed paths"
+	}
+
+	log.WithField("validator", "changed_paths").Debugln("expected content:", expected)
+	log.WithField("validator", "changed_paths").Debugln("actual content:", actual)
+
+	return splitInfof(
+		setFailFormat(not, false, true, false, " changed paths"),
+		-1,
+		-1,
+		expected,
+		actual,
+	)
+}
+
+// changedDocuments returns the changed documents referenced by the Resource, all when not set.
+func (v ChangedPathsValidator) changedDocuments(diff documentsDiff) []diffDocument {
+	if v.Resource == "" {
+		return diff.changed
+	}
+	changed := make([]diffDocument, 0)
+	for _, document := range diff.changed {
+		if matchResourceReference(v.Resource, document.manifest, diff.releaseNamespace) {
+			changed = append(changed, document)
+		}
+	}
+	return changed
+}
+
+// pathsChanged returns whether all Paths changed in the documents, or any path without Paths.
+func (v ChangedPathsValidator) pathsChanged(changed []diffDocument) bool {
+	changedPaths := make([]string, 0)
+	for _, document := range changed {
+		changedPaths = append(changedPaths, document.paths...)
+	}
+	if len(v.Paths) == 0 {
+		return len(changedPaths) > 0
+	}
+
+	for _, path := range v.Paths {
+		if !pathChanged(path, changedPaths) {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate implement Validatable
+func (v ChangedPathsValidator) Validate(context *ValidateContext) (bool, []string) {
+	diff, err := context.documentsDiff()
+	if err != nil {
+		return false, splitInfof(errorFormat, -1, -1, err.Error())
+	}
+
+	changed := v.changedDocuments(diff)
+	if v.pathsChanged(changed) == context.Negative {
+		return false, v.failInfo(changed, context.Negative)
+	}
+
+	return true, []string{}
+}
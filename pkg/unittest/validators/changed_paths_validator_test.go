@@ -0,0 +1,87 @@
+package validators_test
+
+import (
+	"testing"
+
+	. "github.com/helm-unittest/helm-unittest/pkg/unittest/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangedPathsValidatorWhenOk(t *testing.T) {
+	validator := ChangedPathsValidator{Resource: "Deployment/foo", Paths: []string{"spec.replicas"}}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:         docsToTestDiff,
+		BaselineDocs: &baselineDocsToTestDiff,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestChangedPathsValidatorWithReleaseNamespaceWhenOk(t *testing.T) {
+	validator := ChangedPathsValidator{Resource: "Deployment/bar/foo", Paths: []string{"spec.replicas"}}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:          docsToTestDiff,
+		BaselineDocs:  &baselineDocsToTestDiff,
+		RenderContext: RenderContext{Release: map[string]any{"Namespace": "bar"}},
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestChangedPathsValidatorWithParentPathWhenOk(t *testing.T) {
+	validator := ChangedPathsValidator{Paths: []string{"spec"}}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:         docsToTestDiff,
+		BaselineDocs: &baselineDocsToTestDiff,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestChangedPathsValidatorWhenNegativeAndOk(t *testing.T) {
+	validator := ChangedPathsValidator{Resource: "Service", Paths: []string{"spec.ports"}}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:         docsToTestDiff,
+		BaselineDocs: &baselineDocsToTestDiff,
+		Negative:     true,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestChangedPathsValidatorWhenFail(t *testing.T) {
+	validator := ChangedPathsValidator{Paths: []string{"spec.replicas", "spec.template.spec.containers[0].image"}}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:         docsToTestDiff,
+		BaselineDocs: &baselineDocsToTestDiff,
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Expected changed paths:",
+		"	spec.replicas",
+		"	spec.template.spec.containers[0].image",
+		"Actual:",
+		"	Deployment/foo: spec.replicas",
+	}, diff)
+}
+
+func TestChangedPathsValidatorWhenNoChangesFail(t *testing.T) {
+	validator := ChangedPathsValidator{Resource: "Service"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:         docsToTestDiff,
+		BaselineDocs: &baselineDocsToTestDiff,
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Expected changed paths:",
+		"	any paths",
+		"Actual:",
+		"	no changed paths",
+	}, diff)
+}
@@ -1,7 +1,13 @@
 package validators
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
 	"reflect"
 	"regexp"
 	"strings"
@@ -25,14 +31,34 @@ type SnapshotComparer interface {
 	CompareToSnapshot(content any, optFns ...func(options *snapshot.CacheOptions) error) *snapshot.CompareResult
 }
 
+// RenderContext the release, chart and values the chart is rendered with
+type RenderContext struct {
+	Release map[string]any
+	Chart   map[string]any
+	Values  map[string]any
+}
+
 // ValidateContext the context passed to validators
 type ValidateContext struct {
+	// Template the template the documents are rendered from, empty when the documents of multiple templates are validated.
+	Template     string
 	Docs         []common.K8sManifest
 	SelectedDocs *[]common.K8sManifest
 	Negative     bool
 	SnapshotComparer
 	RenderError error
 	FailFast    bool
+	// ClusterObjects the objects which already exist in the (fake) cluster.
+	ClusterObjects []common.K8sManifest
+	RenderContext  RenderContext
+	// BaseDir the directory relative paths of assertions are resolved from, the directory of the test suite.
+	BaseDir string
+	// FileSystem the files of assertions are read from, instead of the OS file system when set.
+	FileSystem fs.FS
+	// BaselineDocs the documents of the baseline the differential assertions compare with, nil without `diffFrom`.
+	BaselineDocs *[]common.K8sManifest
+	// JobContext the context of the test job, done when the job timed out. Defaults to context.Background().
+	JobContext context.Context
 }
 
 func (c *ValidateContext) getManifests() []common.K8sManifest {
@@ -44,6 +70,19 @@ func (c *ValidateContext) getManifests() []common.K8sManifest {
 	}
 }
 
+// Manifests returns the documents to validate, so validators registered outside this package can access them.
+func (c *ValidateContext) Manifests() []common.K8sManifest {
+	return c.getManifests()
+}
+
+// documentsDiff returns the differences of the documents compared to the baseline, or an error without a baseline.
+func (c *ValidateContext) documentsDiff() (documentsDiff, error) {
+	if c.BaselineDocs == nil {
+		return documentsDiff{}, errors.New(errorBaselineFormat)
+	}
+	return diffDocuments(*c.BaselineDocs, c.getManifests(), c.releaseNamespace()), nil
+}
+
 // Validatable all validators must implement Validate method
 type Validatable interface {
 	Validate(context *ValidateContext) (bool, []string)
@@ -154,3 +193,62 @@ func validateRequiredField(actual, field string) error {
 	}
 	return nil
 }
+
+// resolveFile returns the file relative to the base directory, unless the file is an absolute path.
+// Files of the FileSystem are slash separated and relative to its root, absolute when rooted with a slash.
+func (c *ValidateContext) resolveFile(file string) string {
+	if c.FileSystem == nil {
+		if filepath.IsAbs(file) {
+			return file
+		}
+		return filepath.Join(c.BaseDir, file)
+	}
+
+	name := filepath.ToSlash(file)
+	if !strings.HasPrefix(name, "/") {
+		name = path.Join(filepath.ToSlash(c.BaseDir), name)
+	}
+	if name = strings.TrimPrefix(path.Clean(name), "/"); name == "" {
+		return "."
+	}
+	return name
+}
+
+// readFile reads the file of the FileSystem, or of the OS file system when the FileSystem is not set.
+func (c *ValidateContext) readFile(file string) ([]byte, error) {
+	if c.FileSystem == nil {
+		return os.ReadFile(c.resolveFile(file))
+	}
+	return fs.ReadFile(c.FileSystem, c.resolveFile(file))
+}
+
+// jobContext returns the context of the test job, the background context when it is not set.
+func (c *ValidateContext) jobContext() context.Context {
+	if c.JobContext == nil {
+		return context.Background()
+	}
+	return c.JobContext
+}
+
+// releaseNamespace returns the namespace of the release, the documents without a namespace are installed in.
+func (c *ValidateContext) releaseNamespace() string {
+	namespace, _ := c.RenderContext.Release["Namespace"].(string)
+	return namespace
+}
+
+// resourceIdentifier returns the identifier of a manifest as Kind/namespace/name, a manifest without namespace
+// is in the release namespace. The namespace is omitted when neither is set.
+func resourceIdentifier(manifest common.K8sManifest, releaseNamespace string) string {
+	kind, _ := manifest["kind"].(string)
+	metadata, _ := manifest["metadata"].(map[string]any)
+	name, _ := metadata["name"].(string)
+	namespace, _ := metadata["namespace"].(string)
+	if namespace == "" {
+		namespace = releaseNamespace
+	}
+
+	if namespace == "" {
+		return fmt.Sprintf("%s/%s", kind, name)
+	}
+	return fmt.Sprintf("%s/%s/%s", kind, namespace, name)
+}
@@ -0,0 +1,77 @@
+package validators
+
+import (
+	"strings"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+	log "github.com/sirupsen/logrus"
+)
+
+// ContainsLineValidator validate whether the raw content, like a NOTES.txt file, contains the Line.
+// Leading and trailing whitespaces of the lines are ignored.
+type ContainsLineValidator struct {
+	Line string
+}
+
+func (v ContainsLineValidator) failInfo(actual string, manifestIndex int, not bool) []string {
+	log.WithField("validator", "contains_line").Debugln("expected line:", v.Line)
+	log.WithField("validator", "contains_line").Debugln("actual content:", actual)
+
+	return splitInfof(
+		setFailFormat(not, false, true, false, " to contain line"),
+		manifestIndex,
+		-1,
+		v.Line,
+		actual,
+	)
+}
+
+// containsLine checks if one of the lines of the content equals the expected line.
+func (v ContainsLineValidator) containsLine(content string) bool {
+	expected := strings.TrimSpace(v.Line)
+	for line := range strings.Lines(content) {
+		if strings.TrimSpace(line) == expected {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate implement Validatable
+func (v ContainsLineValidator) Validate(context *ValidateContext) (bool, []string) {
+	verr := validateRequiredField(v.Line, "line")
+	if verr != nil {
+		return false, splitInfof(errorFormat, -1, -1, verr.Error())
+	}
+
+	manifests := context.getManifests()
+
+	validateSuccess := false
+	validateErrors := make([]string, 0)
+
+	for manifestIndex, manifest := range manifests {
+		actual := uniformContent(manifest[common.RAW])
+
+		if v.containsLine(actual) == context.Negative {
+			validateSuccess = false
+			errorMessage := v.failInfo(actual, manifestIndex, context.Negative)
+			validateErrors = append(validateErrors, errorMessage...)
+
+			if context.FailFast {
+				break
+			}
+			continue
+		}
+
+		validateSuccess = determineSuccess(manifestIndex, validateSuccess, true)
+	}
+
+	if len(manifests) == 0 && !context.Negative {
+		errorMessage := v.failInfo("no manifest found", -1, context.Negative)
+		validateErrors = append(validateErrors, errorMessage...)
+	} else if len(manifests) == 0 && context.Negative {
+		validateSuccess = true
+	}
+
+	return validateSuccess, validateErrors
+}
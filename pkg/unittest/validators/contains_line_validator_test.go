@@ -0,0 +1,99 @@
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+	. "github.com/helm-unittest/helm-unittest/pkg/unittest/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+var docToTestContainsLine = `
+raw: |
+  1. Get the application URL by running these commands:
+    kubectl port-forward svc/my-app 8080:80
+`
+
+func TestContainsLineValidatorWhenOk(t *testing.T) {
+	validator := ContainsLineValidator{Line: "kubectl port-forward svc/my-app 8080:80"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestContainsLine)},
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestContainsLineValidatorWhenPartialLineFail(t *testing.T) {
+	validator := ContainsLineValidator{Line: "kubectl port-forward"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestContainsLine)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"DocumentIndex:	0",
+		"Expected to contain line:",
+		"	kubectl port-forward",
+		"Actual:",
+		"	1. Get the application URL by running these commands:",
+		"	  kubectl port-forward svc/my-app 8080:80",
+	}, diff)
+}
+
+func TestContainsLineValidatorWhenNegativeAndOk(t *testing.T) {
+	validator := ContainsLineValidator{Line: "kubectl get svc"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:     []common.K8sManifest{makeManifest(docToTestContainsLine)},
+		Negative: true,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestContainsLineValidatorWhenNegativeAndFail(t *testing.T) {
+	validator := ContainsLineValidator{Line: "kubectl port-forward svc/my-app 8080:80"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:     []common.K8sManifest{makeManifest(docToTestContainsLine)},
+		Negative: true,
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"DocumentIndex:	0",
+		"Expected NOT to contain line:",
+		"	kubectl port-forward svc/my-app 8080:80",
+		"Actual:",
+		"	1. Get the application URL by running these commands:",
+		"	  kubectl port-forward svc/my-app 8080:80",
+	}, diff)
+}
+
+func TestContainsLineValidatorWhenLineEmpty(t *testing.T) {
+	validator := ContainsLineValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestContainsLine)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Error:",
+		"	expected field 'line' to be filled",
+	}, diff)
+}
+
+func TestContainsLineValidatorWhenNoManifestFail(t *testing.T) {
+	validator := ContainsLineValidator{Line: "foo"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Expected to contain line:",
+		"	foo",
+		"Actual:",
+		"	no manifest found",
+	}, diff)
+}
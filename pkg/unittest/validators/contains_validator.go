@@ -2,7 +2,6 @@ package validators
 
 import (
 	"fmt"
-	"reflect"
 
 	log "github.com/sirupsen/logrus"
 
@@ -10,12 +9,42 @@ import (
 	"github.com/helm-unittest/helm-unittest/pkg/unittest/valueutils"
 )
 
-// ContainsValidator validate whether value of Path is an array and contains Content
+// ContainsValidator validate whether value of Path is an array and contains Content.
+// Nested lists are compared in any order when IgnoreOrder is set, the values at the IgnorePaths,
+// relative to the elements of the array, are left out of the comparison.
 type ContainsValidator struct {
-	Path    string
-	Content any
-	Count   *int
-	Any     bool
+	Path        string
+	Content     any
+	Count       *int
+	Any         bool
+	IgnoreOrder bool
+	IgnorePaths []string
+}
+
+func (v ContainsValidator) compare() structuralCompare {
+	return structuralCompare{IgnoreOrder: v.IgnoreOrder, IgnorePaths: v.IgnorePaths}
+}
+
+// normalize returns the elements and the content without the values at the IgnorePaths.
+func (v ContainsValidator) normalize(actual []any) ([]any, any, error) {
+	if len(v.IgnorePaths) == 0 {
+		return actual, v.Content, nil
+	}
+
+	content, err := v.compare().normalize(v.Content)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	elements := make([]any, 0, len(actual))
+	for _, ele := range actual {
+		normalized, err := v.compare().normalize(ele)
+		if err != nil {
+			return nil, nil, err
+		}
+		elements = append(elements, normalized)
+	}
+	return elements, content, nil
 }
 
 func (v ContainsValidator) failInfo(actual any, manifestIndex, assertIndex int, not bool) []string {
@@ -36,18 +65,18 @@ func (v ContainsValidator) failInfo(actual any, manifestIndex, assertIndex int,
 	)
 }
 
-func (v ContainsValidator) validateContent(actual []any) (bool, int) {
+func (v ContainsValidator) validateContent(actual []any, content any) (bool, int) {
 	found := false
 	validateFoundCount := 0
 
 	for _, ele := range actual {
-		isArray, isSubmatch := v.isArrayOrSubsetMatch(ele)
+		isArray, isSubmatch := v.isArrayOrSubsetMatch(ele, content)
 		if isSubmatch {
 			found = true
 			validateFoundCount++
 		}
 
-		if v.isExactMatch(isArray, ele) {
+		if v.isExactMatch(isArray, ele, content) {
 			found = true
 			validateFoundCount++
 		}
@@ -56,12 +85,12 @@ func (v ContainsValidator) validateContent(actual []any) (bool, int) {
 	return found, validateFoundCount
 }
 
-func (v ContainsValidator) isArrayOrSubsetMatch(ele any) (bool, bool) {
+func (v ContainsValidator) isArrayOrSubsetMatch(ele, content any) (bool, bool) {
 	if v.Any {
 		subset, subsetOk := ele.(map[string]any)
-		content, contentOk := v.Content.(map[string]any)
+		contentMap, contentOk := content.(map[string]any)
 		if subsetOk && contentOk {
-			return false, validateSubset(subset, content)
+			return false, v.compare().subset(subset, contentMap)
 		} else {
 			return true, false
 		}
@@ -70,8 +99,8 @@ func (v ContainsValidator) isArrayOrSubsetMatch(ele any) (bool, bool) {
 	return false, false
 }
 
-func (v ContainsValidator) isExactMatch(isArray bool, ele any) bool {
-	return (!v.Any || isArray) && reflect.DeepEqual(ele, v.Content)
+func (v ContainsValidator) isExactMatch(isArray bool, ele, content any) bool {
+	return (!v.Any || isArray) && v.compare().equal(content, ele)
 }
 
 func (v ContainsValidator) validateFoundCount(validateFoundCount int) bool {
@@ -80,7 +109,11 @@ func (v ContainsValidator) validateFoundCount(validateFoundCount int) bool {
 
 func (v ContainsValidator) validateSingle(singleActual []any, manifestIndex, assertIndex int, context *ValidateContext) (bool, []string) {
 	validateSingleErrors := []string{}
-	found, validateFoundCount := v.validateContent(singleActual)
+	elements, content, err := v.normalize(singleActual)
+	if err != nil {
+		return false, splitInfof(errorFormat, manifestIndex, assertIndex, err.Error())
+	}
+	found, validateFoundCount := v.validateContent(elements, content)
 
 	if v.Count == nil && (found == context.Negative) {
 		validateSingleErrors = v.failInfo(singleActual, manifestIndex, assertIndex, context.Negative)
@@ -35,10 +35,10 @@ func TestContainsValidatorWhenOk(t *testing.T) {
 	manifest := makeManifest(docToTestContains)
 
 	validator := ContainsValidator{
-		"a.b",
-		map[string]any{"d": "foo bar"},
-		nil,
-		false,
+		Path:    "a.b",
+		Content: map[string]any{"d": "foo bar"},
+		Count:   nil,
+		Any:     false,
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs: []common.K8sManifest{manifest},
@@ -50,10 +50,10 @@ func TestContainsValidatorWhenOk(t *testing.T) {
 
 func TestContainsValidatorWhenEmptyManifestFail(t *testing.T) {
 	validator := ContainsValidator{
-		"a.b",
-		map[string]any{"d": "foo bar"},
-		nil,
-		false,
+		Path:    "a.b",
+		Content: map[string]any{"d": "foo bar"},
+		Count:   nil,
+		Any:     false,
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs: []common.K8sManifest{},
@@ -69,10 +69,10 @@ func TestContainsValidatorWhenEmptyManifestFail(t *testing.T) {
 
 func TestContainsValidatorWhenEmptyManifestNegativeOk(t *testing.T) {
 	validator := ContainsValidator{
-		"a.b",
-		map[string]any{"d": "foo bar"},
-		nil,
-		false,
+		Path:    "a.b",
+		Content: map[string]any{"d": "foo bar"},
+		Count:   nil,
+		Any:     false,
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs:     []common.K8sManifest{},
@@ -94,10 +94,10 @@ a:
 	manifest := makeManifest(multiAssertToTestContains)
 
 	validator := ContainsValidator{
-		"$.*",
-		map[string]any{"d": "foo bar"},
-		nil,
-		false,
+		Path:    "$.*",
+		Content: map[string]any{"d": "foo bar"},
+		Count:   nil,
+		Any:     false,
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs: []common.K8sManifest{manifest},
@@ -112,10 +112,10 @@ func TestMultiManifestContainsValidatorWhenOk(t *testing.T) {
 	manifest2 := makeManifest(docToTestContains2)
 
 	validator := ContainsValidator{
-		"a.b",
-		map[string]any{"d": "foo bar"},
-		nil,
-		false,
+		Path:    "a.b",
+		Content: map[string]any{"d": "foo bar"},
+		Count:   nil,
+		Any:     false,
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs: []common.K8sManifest{manifest1, manifest2},
@@ -135,10 +135,10 @@ a:
 	manifest := makeManifest(docToTestContainsValueOnly)
 
 	validator := ContainsValidator{
-		"a.b",
-		"VALUE1",
-		nil,
-		false,
+		Path:    "a.b",
+		Content: "VALUE1",
+		Count:   nil,
+		Any:     false,
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs: []common.K8sManifest{manifest},
@@ -158,10 +158,10 @@ a:
 	manifest := makeManifest(docToTestContainsValueOnly)
 
 	validator := ContainsValidator{
-		"a.b",
-		"VALUE1",
-		nil,
-		true,
+		Path:    "a.b",
+		Content: "VALUE1",
+		Count:   nil,
+		Any:     true,
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs: []common.K8sManifest{manifest},
@@ -183,10 +183,10 @@ a:
 	manifest := makeManifest(docToTestContainsAny)
 
 	validator := ContainsValidator{
-		"a.b",
-		map[string]any{"name": "VALUE1"},
-		nil,
-		true,
+		Path:    "a.b",
+		Content: map[string]any{"name": "VALUE1"},
+		Count:   nil,
+		Any:     true,
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs: []common.K8sManifest{manifest},
@@ -211,10 +211,10 @@ a:
 	log.SetLevel(log.DebugLevel)
 
 	validator := ContainsValidator{
-		"a.b",
-		map[string]any{"name": "VALUE3"},
-		nil,
-		true,
+		Path:    "a.b",
+		Content: map[string]any{"name": "VALUE3"},
+		Count:   nil,
+		Any:     true,
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs: []common.K8sManifest{manifest},
@@ -250,10 +250,10 @@ a:
 	log.SetLevel(log.DebugLevel)
 
 	validator := ContainsValidator{
-		"a.b",
-		map[string]any{"name": "VALUE3"},
-		nil,
-		true,
+		Path:    "a.b",
+		Content: map[string]any{"name": "VALUE3"},
+		Count:   nil,
+		Any:     true,
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs: []common.K8sManifest{manifest, manifest},
@@ -296,10 +296,10 @@ a:
 	manifest := makeManifest(docToTestContainsAny)
 
 	validator := ContainsValidator{
-		"a.b",
-		map[string]any{"name": "VALUE3"},
-		nil,
-		true,
+		Path:    "a.b",
+		Content: map[string]any{"name": "VALUE3"},
+		Count:   nil,
+		Any:     true,
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs:     []common.K8sManifest{manifest, manifest},
@@ -325,10 +325,10 @@ func TestContainsValidatorWhenNegativeAndOk(t *testing.T) {
 	manifest := makeManifest(docToTestContains)
 
 	validator := ContainsValidator{
-		"a.b",
-		map[string]any{"d": "hello bar"},
-		nil,
-		false,
+		Path:    "a.b",
+		Content: map[string]any{"d": "hello bar"},
+		Count:   nil,
+		Any:     false,
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs:     []common.K8sManifest{manifest},
@@ -343,10 +343,10 @@ func TestContainsValidatorWhenFail(t *testing.T) {
 	manifest := makeManifest(docToTestContains)
 
 	validator := ContainsValidator{
-		"a.b",
-		map[string]any{"e": "bar bar"},
-		nil,
-		false,
+		Path:    "a.b",
+		Content: map[string]any{"e": "bar bar"},
+		Count:   nil,
+		Any:     false,
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs: []common.K8sManifest{manifest},
@@ -378,10 +378,10 @@ a:
 	manifests := []common.K8sManifest{manifest1, manifest2}
 
 	validator := ContainsValidator{
-		"a.b",
-		map[string]any{"d": "foo bar"},
-		nil,
-		false,
+		Path:    "a.b",
+		Content: map[string]any{"d": "foo bar"},
+		Count:   nil,
+		Any:     false,
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs: manifests,
@@ -404,10 +404,10 @@ func TestContainsValidatorMultiManifestWhenBothFail(t *testing.T) {
 	manifests := []common.K8sManifest{manifest1, manifest1}
 
 	validator := ContainsValidator{
-		"a.b",
-		map[string]any{"e": "foo bar"},
-		nil,
-		false,
+		Path:    "a.b",
+		Content: map[string]any{"e": "foo bar"},
+		Count:   nil,
+		Any:     false,
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs: manifests,
@@ -442,10 +442,10 @@ func TestContainsValidatorWhenNegativeAndFail(t *testing.T) {
 	manifest := makeManifest(docToTestContains)
 
 	validator := ContainsValidator{
-		"a.b",
-		map[string]any{"d": "foo bar"},
-		nil,
-		false,
+		Path:    "a.b",
+		Content: map[string]any{"d": "foo bar"},
+		Count:   nil,
+		Any:     false,
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs:     []common.K8sManifest{manifest},
@@ -472,10 +472,10 @@ func TestContainsValidatorMultiDocsWhenNegativeAndFail(t *testing.T) {
 	manifest2 := makeManifest(docToTestContains3)
 
 	validator := ContainsValidator{
-		"a.b",
-		map[string]any{"d": "foo bar"},
-		nil,
-		false,
+		Path:    "a.b",
+		Content: map[string]any{"d": "foo bar"},
+		Count:   nil,
+		Any:     false,
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs:     []common.K8sManifest{manifest1, manifest2},
@@ -507,10 +507,10 @@ a:
 	manifest := makeManifest(manifestDocNotArray)
 
 	validator := ContainsValidator{
-		"a.b",
-		common.K8sManifest{"d": "foo bar"},
-		nil,
-		false,
+		Path:    "a.b",
+		Content: common.K8sManifest{"d": "foo bar"},
+		Count:   nil,
+		Any:     false,
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs: []common.K8sManifest{manifest},
@@ -531,10 +531,10 @@ func TestContainsValidatorWhenInvalidParameter(t *testing.T) {
 	manifest := makeManifest(docToTestContains)
 
 	validator := ContainsValidator{
-		"a.b[e]",
-		common.K8sManifest{"e": "bar"},
-		nil,
-		false,
+		Path:    "a.b[e]",
+		Content: common.K8sManifest{"e": "bar"},
+		Count:   nil,
+		Any:     false,
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs: []common.K8sManifest{manifest},
@@ -552,10 +552,10 @@ func TestContainsValidatorWhenInvalidParameterFailfast(t *testing.T) {
 	manifest := makeManifest(docToTestContains)
 
 	validator := ContainsValidator{
-		"a.b[e]",
-		common.K8sManifest{"e": "bar"},
-		nil,
-		false,
+		Path:    "a.b[e]",
+		Content: common.K8sManifest{"e": "bar"},
+		Count:   nil,
+		Any:     false,
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs:     []common.K8sManifest{manifest, manifest},
@@ -574,10 +574,10 @@ func TestContainsValidatorWhenUnknownPath(t *testing.T) {
 	manifest := makeManifest(docToTestContains)
 
 	validator := ContainsValidator{
-		"a.b[5]",
-		common.K8sManifest{"e": "bar"},
-		nil,
-		false,
+		Path:    "a.b[5]",
+		Content: common.K8sManifest{"e": "bar"},
+		Count:   nil,
+		Any:     false,
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs: []common.K8sManifest{manifest},
@@ -595,10 +595,10 @@ func TestContainsValidatorWhenUnknownPathFailfast(t *testing.T) {
 	manifest := makeManifest(docToTestContains)
 
 	validator := ContainsValidator{
-		"a.b[5]",
-		common.K8sManifest{"e": "bar"},
-		nil,
-		false,
+		Path:    "a.b[5]",
+		Content: common.K8sManifest{"e": "bar"},
+		Count:   nil,
+		Any:     false,
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs:     []common.K8sManifest{manifest, manifest},
@@ -617,10 +617,10 @@ func TestContainsValidatorWhenUnknownPathNegative(t *testing.T) {
 	manifest := makeManifest(docToTestContains)
 
 	validator := ContainsValidator{
-		"a.b[5]",
-		common.K8sManifest{"e": "bar"},
-		nil,
-		false,
+		Path:    "a.b[5]",
+		Content: common.K8sManifest{"e": "bar"},
+		Count:   nil,
+		Any:     false,
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs:     []common.K8sManifest{manifest},
@@ -636,10 +636,10 @@ func TestContainsValidatorWhenMultipleTimesInArray(t *testing.T) {
 
 	counter := 2
 	validator := ContainsValidator{
-		"a.b",
-		map[string]any{"e": "bar"},
-		&counter,
-		false,
+		Path:    "a.b",
+		Content: map[string]any{"e": "bar"},
+		Count:   &counter,
+		Any:     false,
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs: []common.K8sManifest{manifest},
@@ -654,10 +654,10 @@ func TestContainsValidatorInverseWhenNotMultipleTimesInArray(t *testing.T) {
 
 	counter := 1
 	validator := ContainsValidator{
-		"a.b",
-		map[string]any{"e": "bar"},
-		&counter,
-		false,
+		Path:    "a.b",
+		Content: map[string]any{"e": "bar"},
+		Count:   &counter,
+		Any:     false,
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs:     []common.K8sManifest{manifest},
@@ -673,10 +673,10 @@ func TestContainsValidatorWhenNotMultipleTimesInArray(t *testing.T) {
 
 	counter := 1
 	validator := ContainsValidator{
-		"a.b",
-		map[string]any{"e": "bar"},
-		&counter,
-		false,
+		Path:    "a.b",
+		Content: map[string]any{"e": "bar"},
+		Count:   &counter,
+		Any:     false,
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs: []common.K8sManifest{manifest},
@@ -700,10 +700,10 @@ func TestContainsValidatorWhenNotFoundMultipleTimesInArray(t *testing.T) {
 
 	counter := 1
 	validator := ContainsValidator{
-		"a.b",
-		map[string]any{"f": "bar"},
-		&counter,
-		false,
+		Path:    "a.b",
+		Content: map[string]any{"f": "bar"},
+		Count:   &counter,
+		Any:     false,
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs: []common.K8sManifest{manifest},
@@ -729,10 +729,10 @@ func TestContainsValidatorInverseWhenNotFoundMultipleTimesInArray(t *testing.T)
 
 	counter := 1
 	validator := ContainsValidator{
-		"a.b",
-		map[string]any{"f": "bar"},
-		&counter,
-		false,
+		Path:    "a.b",
+		Content: map[string]any{"f": "bar"},
+		Count:   &counter,
+		Any:     false,
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs:     []common.K8sManifest{manifest},
@@ -742,3 +742,44 @@ func TestContainsValidatorInverseWhenNotFoundMultipleTimesInArray(t *testing.T)
 	assert.True(t, pass)
 	assert.Equal(t, []string{}, diff)
 }
+
+func TestContainsValidatorWhenIgnoreOrderAndIgnorePathsOk(t *testing.T) {
+	manifest := makeManifest(`
+spec:
+  volumes:
+    - name: config
+      configMap:
+        name: app-config-8d2f
+        items: [b.yaml, a.yaml]
+`)
+
+	validator := ContainsValidator{
+		Path: "spec.volumes",
+		Content: map[string]any{
+			"name":      "config",
+			"configMap": map[string]any{"items": []any{"a.yaml", "b.yaml"}},
+		},
+		IgnoreOrder: true,
+		IgnorePaths: []string{"configMap.name"},
+	}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{manifest},
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestContainsValidatorWhenIgnorePathsInvalid(t *testing.T) {
+	validator := ContainsValidator{
+		Path:        "a.b",
+		Content:     map[string]any{"d": "foo bar"},
+		IgnorePaths: []string{"[[invalid"},
+	}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestContains)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, "Error:", diff[2])
+}
@@ -0,0 +1,256 @@
+package validators
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+)
+
+// errorBaselineFormat the error of a differential assertion without a baseline
+const errorBaselineFormat = "expected `diffFrom` to be set on the test, to render the baseline"
+
+// documentsDiff the documents added and removed compared to the baseline,
+// and the changed paths of the documents in both.
+type documentsDiff struct {
+	added   []diffDocument
+	removed []diffDocument
+	changed []diffDocument
+	// releaseNamespace the namespace of the documents without a namespace
+	releaseNamespace string
+}
+
+// diffDocument a document of the diff, with the changed paths when it is rendered in both the baseline and the test.
+type diffDocument struct {
+	identifier string
+	manifest   common.K8sManifest
+	paths      []string
+}
+
+// diffDocuments returns the differences of the documents compared to the baseline documents,
+// documents are matched by kind, namespace and name. Documents without a namespace are in the release namespace.
+func diffDocuments(baseline, documents []common.K8sManifest, releaseNamespace string) documentsDiff {
+	baselineIdentifiers, baselineDocs := identifyDocuments(baseline, releaseNamespace)
+	identifiers, docs := identifyDocuments(documents, releaseNamespace)
+
+	diff := documentsDiff{
+		added:            make([]diffDocument, 0),
+		removed:          make([]diffDocument, 0),
+		changed:          make([]diffDocument, 0),
+		releaseNamespace: releaseNamespace,
+	}
+	for _, identifier := range baselineIdentifiers {
+		if _, found := docs[identifier]; !found {
+			diff.removed = append(diff.removed, diffDocument{identifier: identifier, manifest: baselineDocs[identifier]})
+		}
+	}
+	for _, identifier := range identifiers {
+		baselineDoc, found := baselineDocs[identifier]
+		if !found {
+			diff.added = append(diff.added, diffDocument{identifier: identifier, manifest: docs[identifier]})
+			continue
+		}
+		if paths := changedPathsOf(map[string]any(baselineDoc), map[string]any(docs[identifier]), ""); len(paths) > 0 {
+			diff.changed = append(diff.changed, diffDocument{identifier: identifier, manifest: docs[identifier], paths: paths})
+		}
+	}
+	return diff
+}
+
+// identifyDocuments returns the identifiers of the documents in order, and the documents by identifier.
+// Documents with the same identifier get the number of the occurrence appended, like Kind/name#2.
+func identifyDocuments(documents []common.K8sManifest, releaseNamespace string) ([]string, map[string]common.K8sManifest) {
+	identifiers := make([]string, 0, len(documents))
+	docs := make(map[string]common.K8sManifest, len(documents))
+	occurrences := make(map[string]int)
+	for _, document := range documents {
+		identifier := resourceIdentifier(document, releaseNamespace)
+		occurrences[identifier]++
+		if occurrences[identifier] > 1 {
+			identifier = fmt.Sprintf("%s#%d", identifier, occurrences[identifier])
+		}
+		identifiers = append(identifiers, identifier)
+		docs[identifier] = document
+	}
+	return identifiers, docs
+}
+
+// changedPathsOf returns the paths of the values added, removed or changed in the value compared to the baseline.
+func changedPathsOf(baseline, value any, path string) []string {
+	switch base := baseline.(type) {
+	case map[string]any:
+		if current, ok := value.(map[string]any); ok {
+			keys := make([]string, 0, len(base)+len(current))
+			for key := range base {
+				keys = append(keys, key)
+			}
+			for key := range current {
+				if _, found := base[key]; !found {
+					keys = append(keys, key)
+				}
+			}
+			sort.Strings(keys)
+
+			paths := make([]string, 0)
+			for _, key := range keys {
+				baseValue, inBase := base[key]
+				currentValue, inCurrent := current[key]
+				if !inBase || !inCurrent {
+					paths = append(paths, childPath(path, key))
+					continue
+				}
+				paths = append(paths, changedPathsOf(baseValue, currentValue, childPath(path, key))...)
+			}
+			return paths
+		}
+	case []any:
+		if current, ok := value.([]any); ok {
+			paths := make([]string, 0)
+			for index := 0; index < max(len(base), len(current)); index++ {
+				if index >= len(base) || index >= len(current) {
+					paths = append(paths, indexPath(path, index))
+					continue
+				}
+				paths = append(paths, changedPathsOf(base[index], current[index], indexPath(path, index))...)
+			}
+			return paths
+		}
+	}
+
+	if reflect.DeepEqual(baseline, value) {
+		return []string{}
+	}
+	return []string{displayPath(path)}
+}
+
+// pathChanged returns whether the value at the path changed, the value itself, a value within it or containing it.
+func pathChanged(path string, changedPaths []string) bool {
+	for _, changedPath := range changedPaths {
+		if path == changedPath || isWithinPath(changedPath, path) || isWithinPath(path, changedPath) {
+			return true
+		}
+	}
+	return false
+}
+
+// pathAllowed returns whether the changed path is one of the paths or a value within one of them.
+func pathAllowed(changedPath string, paths []string) bool {
+	for _, path := range paths {
+		if changedPath == path || isWithinPath(changedPath, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// isWithinPath returns whether the path is a value within the parent path.
+func isWithinPath(path, parent string) bool {
+	return strings.HasPrefix(path, parent+".") || strings.HasPrefix(path, parent+"[")
+}
+
+// identifiersOf returns the identifiers of the documents
+func identifiersOf(documents []diffDocument) []string {
+	identifiers := make([]string, 0, len(documents))
+	for _, document := range documents {
+		identifiers = append(identifiers, document.identifier)
+	}
+	return identifiers
+}
+
+// matchResourceReference returns whether the manifest is referenced by the resource,
+// referenced as Kind, Kind/name or Kind/namespace/name. A manifest without namespace is in the release namespace.
+func matchResourceReference(resource string, manifest common.K8sManifest, releaseNamespace string) bool {
+	parts := strings.Split(resource, "/")
+	identifier := strings.Split(resourceIdentifier(manifest, releaseNamespace), "/")
+
+	switch len(parts) {
+	case 1:
+		return parts[0] == identifier[0]
+	case 2:
+		return parts[0] == identifier[0] && parts[1] == identifier[len(identifier)-1]
+	default:
+		return resource == strings.Join(identifier, "/")
+	}
+}
+
+// documentsOfDiff the expected added or removed documents of the diff,
+// referenced as Kind, Kind/name or Kind/namespace/name.
+type documentsOfDiff struct {
+	change    string
+	resources []string
+	count     *int
+	// releaseNamespace the namespace of the documents without a namespace
+	releaseNamespace string
+}
+
+// matches returns whether the documents are exactly the referenced resources and have the count, if set.
+// Without resources and count, any document matches.
+func (d documentsOfDiff) matches(documents []diffDocument) bool {
+	if d.count != nil && len(documents) != *d.count {
+		return false
+	}
+	if len(d.resources) == 0 {
+		return d.count != nil || len(documents) > 0
+	}
+
+	matched := make([]bool, len(documents))
+	for _, resource := range d.resources {
+		found := false
+		for index, document := range documents {
+			if matchResourceReference(resource, document.manifest, d.releaseNamespace) {
+				matched[index] = true
+				found = true
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	for _, isMatched := range matched {
+		if !isMatched {
+			return false
+		}
+	}
+	return true
+}
+
+// expected returns the description of the expected documents
+func (d documentsOfDiff) expected() string {
+	expected := make([]string, 0, 2)
+	if d.count != nil {
+		expected = append(expected, fmt.Sprintf("count: %d", *d.count))
+	}
+	if len(d.resources) > 0 {
+		expected = append(expected, strings.Join(d.resources, "\n"))
+	}
+	if len(expected) == 0 {
+		return "any documents"
+	}
+	return strings.Join(expected, "\n")
+}
+
+// validate validates the added or removed documents, the diff is logged by the validator.
+func (d documentsOfDiff) validate(documents []diffDocument, not bool) (bool, []string) {
+	if d.matches(documents) != not {
+		return true, []string{}
+	}
+
+	actual := strings.Join(identifiersOf(documents), "\n")
+	if actual == "" {
+		actual = "no documents"
+	}
+	log.WithField("validator", d.change+"_documents").Debugln("expected content:", d.expected())
+	log.WithField("validator", d.change+"_documents").Debugln("actual content:", actual)
+
+	return false, splitInfof(
+		setFailFormat(not, false, true, false, " "+d.change+" documents"),
+		-1,
+		-1,
+		d.expected(),
+		actual,
+	)
+}
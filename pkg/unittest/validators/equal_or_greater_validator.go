@@ -1,9 +1,11 @@
 package validators
 
-// EqualOrGreaterValidator validate whether the value of Path is greater or equal to Value
+// EqualOrGreaterValidator validate whether the value of Path is greater or equal to Value.
+// The values are compared as quantities or durations when CompareAs is set to quantity or duration.
 type EqualOrGreaterValidator struct {
-	Path  string
-	Value any
+	Path      string
+	Value     any
+	CompareAs string
 }
 
 // Validate implement Validatable
@@ -12,6 +14,7 @@ func (g EqualOrGreaterValidator) Validate(context *ValidateContext) (bool, []str
 		Path:           g.Path,
 		Value:          g.Value,
 		ComparisonType: "greater",
+		CompareAs:      g.CompareAs,
 	}
 
 	return operatorValidator.Validate(context)
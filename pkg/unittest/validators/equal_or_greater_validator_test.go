@@ -331,3 +331,147 @@ func TestEqualOrGreaterValidatorWhenNoManifestNegativeOk(t *testing.T) {
 	assert.True(t, pass)
 	assert.Equal(t, []string{}, diff)
 }
+
+func TestEqualOrGreaterValidatorCompareAsOk(t *testing.T) {
+	tests := []struct {
+		name, doc, compareAs string
+		value                any
+	}{
+		{name: "cpu quantity", doc: "value: 1", compareAs: "quantity", value: "500m"},
+		{name: "memory quantity", doc: "value: 1Gi", compareAs: "quantity", value: "512Mi"},
+		{name: "float quantity", doc: "value: 0.5", compareAs: "quantity", value: "500m"},
+		{name: "duration", doc: "value: 1m30s", compareAs: "duration", value: "90s"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := EqualOrGreaterValidator{
+				Path:      "value",
+				Value:     tt.value,
+				CompareAs: tt.compareAs,
+			}
+			pass, diff := v.Validate(&ValidateContext{
+				Docs: []common.K8sManifest{makeManifest(tt.doc)},
+			})
+
+			assert.True(t, pass)
+			assert.Equal(t, []string{}, diff)
+		})
+	}
+}
+
+func TestEqualOrGreaterValidatorCompareAsQuantityFail(t *testing.T) {
+	v := EqualOrGreaterValidator{
+		Path:      "value",
+		Value:     "1",
+		CompareAs: "quantity",
+	}
+	pass, diff := v.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest("value: 500m")},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"DocumentIndex:\t0",
+		"ValuesIndex:\t0",
+		"Path:\tvalue",
+		"Expected to be greater then or equal to, got:",
+		"\tthe actual '500m' is not greater or equal to the expected '1'",
+	}, diff)
+}
+
+func TestEqualOrGreaterValidatorCompareAsInvalidValue(t *testing.T) {
+	tests := []struct {
+		name, doc, compareAs string
+		value                any
+		errorMsg             string
+	}{
+		{name: "invalid quantity", doc: "value: lots", compareAs: "quantity", value: "1", errorMsg: "\t'lots' is not a valid quantity"},
+		{name: "invalid duration", doc: "value: 90", compareAs: "duration", value: "1m", errorMsg: "\t'90' of type 'int' is not a valid duration"},
+		{name: "invalid compareAs", doc: "value: 1", compareAs: "size", value: 1, errorMsg: "\tunsupported compareAs 'size', expected one of quantity or duration"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := EqualOrGreaterValidator{
+				Path:      "value",
+				Value:     tt.value,
+				CompareAs: tt.compareAs,
+			}
+			pass, diff := v.Validate(&ValidateContext{
+				Docs: []common.K8sManifest{makeManifest(tt.doc)},
+			})
+
+			assert.False(t, pass)
+			assert.Equal(t, []string{"DocumentIndex:\t0", "ValuesIndex:\t0", "Error:", tt.errorMsg}, diff)
+		})
+	}
+}
+
+func TestEqualOrGreaterValidatorWhenNegativeAndFail(t *testing.T) {
+	tests := []struct {
+		name, doc, compareAs string
+		value                any
+		actualMsg            string
+	}{
+		{name: "greater int", doc: "value: 4", value: 3, actualMsg: "\tthe actual '4' is greater or equal to the expected '3'"},
+		{name: "equal int", doc: "value: 3", value: 3, actualMsg: "\tthe actual '3' is greater or equal to the expected '3'"},
+		{name: "float and int", doc: "value: 3.5", value: 3, actualMsg: "\tthe actual '3.5' is greater or equal to the expected '3'"},
+		{name: "string", doc: "value: b", value: "a", actualMsg: "\tthe actual 'b' is greater or equal to the expected 'a'"},
+		{name: "quantity", doc: "value: 4", value: 3, compareAs: "quantity", actualMsg: "\tthe actual '4' is greater or equal to the expected '3'"},
+		{name: "duration", doc: "value: 2m", value: "90s", compareAs: "duration", actualMsg: "\tthe actual '2m' is greater or equal to the expected '90s'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := EqualOrGreaterValidator{
+				Path:      "value",
+				Value:     tt.value,
+				CompareAs: tt.compareAs,
+			}
+			pass, diff := v.Validate(&ValidateContext{
+				Docs:     []common.K8sManifest{makeManifest(tt.doc)},
+				Negative: true,
+			})
+
+			assert.False(t, pass)
+			assert.Equal(t, []string{
+				"DocumentIndex:\t0",
+				"ValuesIndex:\t0",
+				"Path:\tvalue",
+				"Expected NOT to be greater then or equal to, got:",
+				tt.actualMsg,
+			}, diff)
+		})
+	}
+}
+
+func TestEqualOrGreaterValidatorWhenNegativeAndOk(t *testing.T) {
+	for _, compareAs := range []string{"", "quantity"} {
+		v := EqualOrGreaterValidator{
+			Path:      "value",
+			Value:     3,
+			CompareAs: compareAs,
+		}
+		pass, diff := v.Validate(&ValidateContext{
+			Docs:     []common.K8sManifest{makeManifest("value: 2")},
+			Negative: true,
+		})
+
+		assert.True(t, pass, compareAs)
+		assert.Equal(t, []string{}, diff)
+	}
+}
+
+func TestEqualOrGreaterValidatorWhenTypesDoNotMatchOnSecondValue(t *testing.T) {
+	v := EqualOrGreaterValidator{
+		Path:  "a.*",
+		Value: 1,
+	}
+	pass, diff := v.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest("a:\n  b: 2\n  c: high\n")},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{"DocumentIndex:\t0", "ValuesIndex:\t1", "Error:", "\tactual 'string' and expected 'int' types do not match"}, diff)
+}
@@ -1,9 +1,11 @@
 package validators
 
-// EqualOrLessValidator validate whether the value of Path is less or equal to Value
+// EqualOrLessValidator validate whether the value of Path is less or equal to Value.
+// The values are compared as quantities or durations when CompareAs is set to quantity or duration.
 type EqualOrLessValidator struct {
-	Path  string
-	Value any
+	Path      string
+	Value     any
+	CompareAs string
 }
 
 // Validate implement Validatable
@@ -12,6 +14,7 @@ func (l EqualOrLessValidator) Validate(context *ValidateContext) (bool, []string
 		Path:           l.Path,
 		Value:          l.Value,
 		ComparisonType: "less",
+		CompareAs:      l.CompareAs,
 	}
 
 	return operatorValidator.Validate(context)
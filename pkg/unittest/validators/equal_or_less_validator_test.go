@@ -180,7 +180,7 @@ spec:
 	assert.Equal(t, []string{}, diff)
 }
 
-func TestEqualOrLessValidatorWhenTypesDoNotMatch(t *testing.T) {
+func TestEqualOrLessValidatorWhenIntAndFloat(t *testing.T) {
 	var actual = "value: 0.3"
 	manifest := makeManifest(actual)
 
@@ -192,12 +192,28 @@ func TestEqualOrLessValidatorWhenTypesDoNotMatch(t *testing.T) {
 		Docs: []common.K8sManifest{manifest},
 	})
 
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestEqualOrLessValidatorWhenTypesDoNotMatch(t *testing.T) {
+	var actual = "value: 0.3"
+	manifest := makeManifest(actual)
+
+	v := EqualOrLessValidator{
+		Path:  "value",
+		Value: "1",
+	}
+	pass, diff := v.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{manifest},
+	})
+
 	assert.False(t, pass)
 	assert.Equal(t, []string{
 		"DocumentIndex:	0",
 		"ValuesIndex:	0",
 		"Error:",
-		"	actual 'float64' and expected 'int' types do not match",
+		"	actual 'float64' and expected 'string' types do not match",
 	}, diff)
 }
 
@@ -260,3 +276,50 @@ func TestEqualOrLessValidatorWhenNoManifestNegativeOk(t *testing.T) {
 	assert.True(t, pass)
 	assert.Equal(t, []string{}, diff)
 }
+
+func TestEqualOrLessValidatorCompareAsOk(t *testing.T) {
+	tests := []struct {
+		name, doc, compareAs string
+		value                any
+	}{
+		{name: "cpu quantity", doc: "value: 500m", compareAs: "quantity", value: 1},
+		{name: "memory quantity", doc: "value: 512Mi", compareAs: "quantity", value: "1Gi"},
+		{name: "duration", doc: "value: 45s", compareAs: "duration", value: "1m"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := EqualOrLessValidator{
+				Path:      "value",
+				Value:     tt.value,
+				CompareAs: tt.compareAs,
+			}
+			pass, diff := v.Validate(&ValidateContext{
+				Docs: []common.K8sManifest{makeManifest(tt.doc)},
+			})
+
+			assert.True(t, pass)
+			assert.Equal(t, []string{}, diff)
+		})
+	}
+}
+
+func TestEqualOrLessValidatorCompareAsDurationFail(t *testing.T) {
+	v := EqualOrLessValidator{
+		Path:      "value",
+		Value:     "1m",
+		CompareAs: "duration",
+	}
+	pass, diff := v.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest("value: 1h")},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"DocumentIndex:\t0",
+		"ValuesIndex:\t0",
+		"Path:\tvalue",
+		"Expected to be less then or equal to, got:",
+		"\tthe actual '1h' is not less or equal to the expected '1m'",
+	}, diff)
+}
@@ -3,7 +3,7 @@ package validators
 import (
 	"encoding/base64"
 	"fmt"
-	"reflect"
+	"strings"
 
 	log "github.com/sirupsen/logrus"
 
@@ -11,15 +11,30 @@ import (
 	"github.com/helm-unittest/helm-unittest/pkg/unittest/valueutils"
 )
 
-// EqualValidator validate whether the value of Path equal to Value
+// equalDifferencesFormat the failure format of structured values, listing the differing paths.
+const equalDifferencesFormat = `
+Path:%s
+Expected to equal, differences:
+%s
+`
+
+// EqualValidator validate whether the value of Path equal to Value.
+// Lists are compared in any order when IgnoreOrder is set, the values at the IgnorePaths,
+// relative to the value of Path, are left out of the comparison.
 type EqualValidator struct {
 	Path         string
 	Value        any
 	DecodeBase64 bool `yaml:"decodeBase64"`
+	IgnoreOrder  bool
+	IgnorePaths  []string
+}
+
+func (a EqualValidator) compare() structuralCompare {
+	return structuralCompare{IgnoreOrder: a.IgnoreOrder, IgnorePaths: a.IgnorePaths}
 }
 
-func (a EqualValidator) failInfo(actual any, manifestIndex, actualIndex int, not bool) []string {
-	expectedYAML := common.TrustedMarshalYAML(a.Value)
+func (a EqualValidator) failInfo(expected, actual any, manifestIndex, actualIndex int, not bool) []string {
+	expectedYAML := common.TrustedMarshalYAML(expected)
 	actualYAML := common.TrustedMarshalYAML(actual)
 	customMessage := " to equal"
 
@@ -36,6 +51,16 @@ func (a EqualValidator) failInfo(actual any, manifestIndex, actualIndex int, not
 		)
 	}
 
+	if isStructured(expected) && isStructured(actual) {
+		return splitInfof(
+			equalDifferencesFormat,
+			manifestIndex,
+			actualIndex,
+			a.Path,
+			strings.Join(a.compare().differences(expected, actual, ""), "\n"),
+		)
+	}
+
 	return splitInfof(
 		setFailFormat(not, true, true, true, customMessage),
 		manifestIndex,
@@ -84,8 +109,17 @@ func (a EqualValidator) validateSingleActual(actual any, manifestIndex, actualIn
 		actual = uniformContent(s)
 	}
 
-	if reflect.DeepEqual(a.Value, actual) == context.Negative {
-		return false, a.failInfo(actual, manifestIndex, actualIndex, context.Negative)
+	expected, err := a.compare().normalize(a.Value)
+	if err != nil {
+		return false, splitInfof(errorFormat, manifestIndex, actualIndex, err.Error())
+	}
+	actual, err = a.compare().normalize(actual)
+	if err != nil {
+		return false, splitInfof(errorFormat, manifestIndex, actualIndex, err.Error())
+	}
+
+	if a.compare().equal(expected, actual) == context.Negative {
+		return false, a.failInfo(expected, actual, manifestIndex, actualIndex, context.Negative)
 	}
 
 	return true, []string{}
@@ -109,7 +143,7 @@ func (a EqualValidator) Validate(context *ValidateContext) (bool, []string) {
 	}
 
 	if len(manifests) == 0 && !context.Negative {
-		errorMessage := a.failInfo("no manifest found", -1, -1, context.Negative)
+		errorMessage := a.failInfo(a.Value, "no manifest found", -1, -1, context.Negative)
 		validateErrors = append(validateErrors, errorMessage...)
 	} else if len(manifests) == 0 && context.Negative {
 		validateSuccess = true
@@ -0,0 +1,147 @@
+package validators
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+	log "github.com/sirupsen/logrus"
+)
+
+// execWaitDelay the time to wait for the output of a killed command, when its children keep the output open
+const execWaitDelay = time.Second
+
+// execInput the JSON document the command of the ExecValidator reads from stdin.
+type execInput struct {
+	Template  string               `json:"template"`
+	Documents []common.K8sManifest `json:"documents"`
+	Release   map[string]any       `json:"release"`
+	Chart     map[string]any       `json:"chart"`
+	Values    map[string]any       `json:"values"`
+}
+
+// execOutput the JSON document the command of the ExecValidator writes to stdout.
+type execOutput struct {
+	Passed   *bool    `json:"passed"`
+	FailInfo []string `json:"failInfo"`
+}
+
+// ExecValidator validate the documents with an external command, the same way as a post-renderer is run.
+// The command reads the documents, template, release, chart and values as JSON from stdin
+// and writes whether they passed with the failure information as JSON to stdout.
+type ExecValidator struct {
+	Cmd  string
+	Args []string
+}
+
+func (v ExecValidator) failInfo(actual string, not bool) []string {
+	command := strings.Join(append([]string{v.Cmd}, v.Args...), " ")
+
+	log.WithField("validator", "exec").Debugln("command:", command)
+	log.WithField("validator", "exec").Debugln("actual:", actual)
+
+	return splitInfof(
+		setFailFormat(not, false, true, false, " to pass the command"),
+		-1,
+		-1,
+		command,
+		actual,
+	)
+}
+
+// command returns the path of the command, relative commands are resolved from the base directory
+// and commands without a path are searched in the PATH.
+// Commands of the FileSystem can not be run, only the commands without a path.
+func (v ExecValidator) command(context *ValidateContext) (string, error) {
+	if !strings.ContainsRune(v.Cmd, '/') && !strings.ContainsRune(v.Cmd, filepath.Separator) {
+		return v.Cmd, nil
+	}
+	if context.FileSystem != nil {
+		return "", fmt.Errorf("command %s can not be run from the FileSystem, only commands in the PATH are supported with a FileSystem", v.Cmd)
+	}
+	return context.resolveFile(v.Cmd), nil
+}
+
+// run runs the command with the input on stdin and decodes the output from stdout.
+// The command is killed when the test job times out.
+func (v ExecValidator) run(input execInput, context *ValidateContext) (execOutput, error) {
+	output := execOutput{}
+
+	command, err := v.command(context)
+	if err != nil {
+		return output, err
+	}
+	binaryPath, err := exec.LookPath(command)
+	if err != nil {
+		return output, fmt.Errorf("unable to find binary at %s: %w", v.Cmd, err)
+	}
+
+	content, err := json.Marshal(input)
+	if err != nil {
+		return output, err
+	}
+
+	ctx := context.jobContext()
+	cmd := exec.CommandContext(ctx, binaryPath, v.Args...)
+	cmd.WaitDelay = execWaitDelay
+	cmd.Stdin = bytes.NewBuffer(content)
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	err = cmd.Run()
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return output, fmt.Errorf("command %s stopped as the test job timed out: %w", v.Cmd, ctxErr)
+	}
+	if err != nil {
+		return output, fmt.Errorf("error while running command %s. error output:\n%s: %w", v.Cmd, stderr.String(), err)
+	}
+
+	if err := json.Unmarshal(stdout.Bytes(), &output); err != nil {
+		return output, fmt.Errorf("expected the output of command %s to be JSON with 'passed' and 'failInfo', got:\n%s", v.Cmd, stdout.String())
+	}
+	if output.Passed == nil {
+		return output, fmt.Errorf("expected the output of command %s to contain 'passed', got:\n%s", v.Cmd, stdout.String())
+	}
+	return output, nil
+}
+
+// Validate implement Validatable
+func (v ExecValidator) Validate(context *ValidateContext) (bool, []string) {
+	verr := validateRequiredField(v.Cmd, "cmd")
+	if verr != nil {
+		return false, splitInfof(errorFormat, -1, -1, verr.Error())
+	}
+
+	documents := context.getManifests()
+	if documents == nil {
+		documents = []common.K8sManifest{}
+	}
+
+	output, err := v.run(execInput{
+		Template:  context.Template,
+		Documents: documents,
+		Release:   context.RenderContext.Release,
+		Chart:     context.RenderContext.Chart,
+		Values:    context.RenderContext.Values,
+	}, context)
+	if err != nil {
+		return false, splitInfof(errorFormat, -1, -1, err.Error())
+	}
+
+	if *output.Passed == context.Negative {
+		actual := strings.Join(output.FailInfo, "\n")
+		if *output.Passed || actual == "" {
+			actual = fmt.Sprintf("passed: %t", *output.Passed)
+		}
+		return false, v.failInfo(actual, context.Negative)
+	}
+
+	return true, []string{}
+}
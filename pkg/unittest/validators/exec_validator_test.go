@@ -0,0 +1,247 @@
+package validators_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+	. "github.com/helm-unittest/helm-unittest/pkg/unittest/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+var docToTestExec = `
+kind: Deployment
+metadata:
+  name: my-app
+  labels:
+    owner: platform
+`
+
+var scriptToTestExec = `#!/bin/sh
+input=$(cat)
+case "$input" in
+  *'"owner":"platform"'*) echo '{"passed": true}' ;;
+  *) echo '{"passed": false, "failInfo": ["owner label is not platform", "see the ownership guide"]}' ;;
+esac
+`
+
+// writeScript writes the script as an executable to a temporary directory and returns the directory.
+func writeScript(t *testing.T, name, script string) string {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("shell scripts are not supported on windows")
+	}
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(script), 0755))
+	return dir
+}
+
+func TestExecValidatorWhenOk(t *testing.T) {
+	dir := writeScript(t, "check.sh", scriptToTestExec)
+
+	validator := ExecValidator{Cmd: "./check.sh"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:    []common.K8sManifest{makeManifest(docToTestExec)},
+		BaseDir: dir,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestExecValidatorWhenFail(t *testing.T) {
+	dir := writeScript(t, "check.sh", scriptToTestExec)
+
+	validator := ExecValidator{Cmd: "./check.sh", Args: []string{"--strict"}}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:    []common.K8sManifest{makeManifest("kind: Deployment")},
+		BaseDir: dir,
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Expected to pass the command:",
+		"	./check.sh --strict",
+		"Actual:",
+		"	owner label is not platform",
+		"	see the ownership guide",
+	}, diff)
+}
+
+func TestExecValidatorWhenNegativeAndOk(t *testing.T) {
+	dir := writeScript(t, "check.sh", scriptToTestExec)
+
+	validator := ExecValidator{Cmd: "./check.sh"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:     []common.K8sManifest{makeManifest("kind: Deployment")},
+		Negative: true,
+		BaseDir:  dir,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestExecValidatorWhenNegativeAndFail(t *testing.T) {
+	dir := writeScript(t, "check.sh", scriptToTestExec)
+
+	validator := ExecValidator{Cmd: "./check.sh"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:     []common.K8sManifest{makeManifest(docToTestExec)},
+		Negative: true,
+		BaseDir:  dir,
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Expected NOT to pass the command:",
+		"	./check.sh",
+		"Actual:",
+		"	passed: true",
+	}, diff)
+}
+
+func TestExecValidatorSendsInput(t *testing.T) {
+	dir := writeScript(t, "record.sh", "#!/bin/sh\ncat > \"$1\"\necho '{\"passed\": true}'\n")
+	inputFile := filepath.Join(dir, "input.json")
+
+	validator := ExecValidator{Cmd: "./record.sh", Args: []string{inputFile}}
+	pass, diff := validator.Validate(&ValidateContext{
+		Template: "templates/deployment.yaml",
+		Docs:     []common.K8sManifest{makeManifest(docToTestExec)},
+		RenderContext: RenderContext{
+			Release: map[string]any{"Name": "my-release"},
+			Chart:   map[string]any{"Name": "my-chart"},
+			Values:  map[string]any{"replicas": 2},
+		},
+		BaseDir: dir,
+	})
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+
+	content, err := os.ReadFile(inputFile)
+	assert.NoError(t, err)
+	var input map[string]any
+	assert.NoError(t, json.Unmarshal(content, &input))
+	assert.Equal(t, map[string]any{
+		"template": "templates/deployment.yaml",
+		"documents": []any{map[string]any{
+			"kind":     "Deployment",
+			"metadata": map[string]any{"name": "my-app", "labels": map[string]any{"owner": "platform"}},
+		}},
+		"release": map[string]any{"Name": "my-release"},
+		"chart":   map[string]any{"Name": "my-chart"},
+		"values":  map[string]any{"replicas": float64(2)},
+	}, input)
+}
+
+func TestExecValidatorWhenNoManifestSendsEmptyDocuments(t *testing.T) {
+	dir := writeScript(t, "empty.sh", "#!/bin/sh\ngrep -q '\"documents\":\\[\\]' && echo '{\"passed\": true}' || echo '{\"passed\": false}'\n")
+
+	validator := ExecValidator{Cmd: "./empty.sh"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:    []common.K8sManifest{},
+		BaseDir: dir,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestExecValidatorWhenOutputInvalid(t *testing.T) {
+	dir := writeScript(t, "invalid.sh", "#!/bin/sh\ncat > /dev/null\necho 'all good'\n")
+
+	validator := ExecValidator{Cmd: "./invalid.sh"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:    []common.K8sManifest{makeManifest(docToTestExec)},
+		BaseDir: dir,
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Error:",
+		"	expected the output of command ./invalid.sh to be JSON with 'passed' and 'failInfo', got:",
+		"	all good",
+	}, diff)
+}
+
+func TestExecValidatorWhenOutputMissesPassed(t *testing.T) {
+	dir := writeScript(t, "missing.sh", "#!/bin/sh\ncat > /dev/null\necho '{\"failInfo\": []}'\n")
+
+	validator := ExecValidator{Cmd: "./missing.sh"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:    []common.K8sManifest{makeManifest(docToTestExec)},
+		BaseDir: dir,
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Error:",
+		"	expected the output of command ./missing.sh to contain 'passed', got:",
+		"	{\"failInfo\": []}",
+	}, diff)
+}
+
+func TestExecValidatorWhenCommandFails(t *testing.T) {
+	dir := writeScript(t, "exit.sh", "#!/bin/sh\ncat > /dev/null\necho 'broken' >&2\nexit 1\n")
+
+	validator := ExecValidator{Cmd: "./exit.sh"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:    []common.K8sManifest{makeManifest(docToTestExec)},
+		BaseDir: dir,
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, "Error:", diff[0])
+	assert.Contains(t, diff[1], "exit.sh")
+	assert.Contains(t, diff, "\tbroken")
+}
+
+func TestExecValidatorWhenJobContextDone(t *testing.T) {
+	dir := writeScript(t, "sleep.sh", "#!/bin/sh\nexec sleep 10\n")
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	validator := ExecValidator{Cmd: "./sleep.sh"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:       []common.K8sManifest{makeManifest(docToTestExec)},
+		BaseDir:    dir,
+		JobContext: ctx,
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Error:",
+		"	command ./sleep.sh stopped as the test job timed out: context deadline exceeded",
+	}, diff)
+}
+
+func TestExecValidatorWhenCommandNotFound(t *testing.T) {
+	validator := ExecValidator{Cmd: "./notfound.sh"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:    []common.K8sManifest{makeManifest(docToTestExec)},
+		BaseDir: t.TempDir(),
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, "Error:", diff[0])
+	assert.Contains(t, diff[1], "notfound.sh")
+}
+
+func TestExecValidatorWhenCmdEmpty(t *testing.T) {
+	validator := ExecValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestExec)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Error:",
+		"	expected field 'cmd' to be filled",
+	}, diff)
+}
@@ -0,0 +1,140 @@
+package validators
+
+import (
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	"github.com/helm-unittest/helm-unittest/internal/common"
+	log "github.com/sirupsen/logrus"
+)
+
+// ExpressionValidator validate whether the CEL Expression evaluates to true for every document.
+// The document is available as `object`, all documents of the template as `documents`,
+// and the `release`, `chart` and `values` the chart is rendered with.
+type ExpressionValidator struct {
+	Expression string
+}
+
+func (v ExpressionValidator) failInfo(actual any, manifestIndex int, not bool) []string {
+	actualYAML := common.TrustedMarshalYAML(actual)
+
+	log.WithField("validator", "expression").Debugln("expected expression:", v.Expression)
+	log.WithField("validator", "expression").Debugln("actual content:", actualYAML)
+
+	return splitInfof(
+		setFailFormat(not, false, true, false, " expression to be true"),
+		manifestIndex,
+		-1,
+		v.Expression,
+		actualYAML,
+	)
+}
+
+// program compiles the expression into a program evaluating to a boolean.
+func (v ExpressionValidator) program() (cel.Program, error) {
+	env, err := cel.NewEnv(
+		cel.Variable("object", cel.DynType),
+		cel.Variable("documents", cel.ListType(cel.DynType)),
+		cel.Variable("release", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("chart", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("values", cel.MapType(cel.StringType, cel.DynType)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ast, issues := env.Compile(v.Expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("expected expression to return a bool, got %s", ast.OutputType())
+	}
+
+	return env.Program(ast)
+}
+
+// evaluate evaluates the program for the manifest.
+func (v ExpressionValidator) evaluate(program cel.Program, manifest common.K8sManifest, context *ValidateContext) (bool, error) {
+	documents := make([]any, 0, len(context.Docs))
+	for _, doc := range context.Docs {
+		documents = append(documents, doc)
+	}
+
+	out, _, err := program.Eval(map[string]any{
+		"object":    manifest,
+		"documents": documents,
+		"release":   emptyWhenNil(context.RenderContext.Release),
+		"chart":     emptyWhenNil(context.RenderContext.Chart),
+		"values":    emptyWhenNil(context.RenderContext.Values),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	result, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expected expression to return a bool, got %v", out.Value())
+	}
+	return result, nil
+}
+
+// Validate implement Validatable
+func (v ExpressionValidator) Validate(context *ValidateContext) (bool, []string) {
+	verr := validateRequiredField(v.Expression, "expression")
+	if verr != nil {
+		return false, splitInfof(errorFormat, -1, -1, verr.Error())
+	}
+
+	program, err := v.program()
+	if err != nil {
+		return false, splitInfof(errorFormat, -1, -1, err.Error())
+	}
+
+	manifests := context.getManifests()
+
+	validateSuccess := false
+	validateErrors := make([]string, 0)
+
+	for manifestIndex, manifest := range manifests {
+		result, err := v.evaluate(program, manifest, context)
+		if err != nil {
+			validateSuccess = false
+			errorMessage := splitInfof(errorFormat, manifestIndex, -1, err.Error())
+			validateErrors = append(validateErrors, errorMessage...)
+			if context.FailFast {
+				break
+			}
+			continue
+		}
+
+		if result == context.Negative {
+			validateSuccess = false
+			errorMessage := v.failInfo(manifest, manifestIndex, context.Negative)
+			validateErrors = append(validateErrors, errorMessage...)
+			if context.FailFast {
+				break
+			}
+			continue
+		}
+
+		validateSuccess = determineSuccess(manifestIndex, validateSuccess, true)
+	}
+
+	if len(manifests) == 0 && !context.Negative {
+		errorMessage := v.failInfo("no manifest found", -1, context.Negative)
+		validateErrors = append(validateErrors, errorMessage...)
+	} else if len(manifests) == 0 && context.Negative {
+		validateSuccess = true
+	}
+
+	return validateSuccess, validateErrors
+}
+
+// emptyWhenNil ensures a map is always available, so expressions can use has() on it.
+func emptyWhenNil(values map[string]any) map[string]any {
+	if values == nil {
+		return map[string]any{}
+	}
+	return values
+}
@@ -0,0 +1,147 @@
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+	. "github.com/helm-unittest/helm-unittest/pkg/unittest/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+var docToTestExpression = `
+kind: Deployment
+metadata:
+  name: my-app
+  labels:
+    team: platform
+spec:
+  replicas: 3
+  minReadySeconds: 5
+`
+
+var docToTestExpressionService = `
+kind: Service
+metadata:
+  name: my-app
+`
+
+func TestExpressionValidatorWhenOk(t *testing.T) {
+	validator := ExpressionValidator{Expression: "object.spec.replicas >= 2 && object.metadata.labels['team'] != ''"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestExpression)},
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestExpressionValidatorWhenComparingFieldsOk(t *testing.T) {
+	validator := ExpressionValidator{Expression: "object.spec.replicas > object.spec.minReadySeconds - 3"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestExpression)},
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestExpressionValidatorWhenRenderContextOk(t *testing.T) {
+	validator := ExpressionValidator{
+		Expression: "object.metadata.name == release.Name + '-' + chart.Name && values.replicas == object.spec.replicas && documents.exists(d, d.kind == 'Service')",
+	}
+	deployment := makeManifest(docToTestExpression)
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:         []common.K8sManifest{deployment, makeManifest(docToTestExpressionService)},
+		SelectedDocs: &[]common.K8sManifest{deployment},
+		RenderContext: RenderContext{
+			Release: map[string]any{"Name": "my"},
+			Chart:   map[string]any{"Name": "app"},
+			Values:  map[string]any{"replicas": 3},
+		},
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestExpressionValidatorWhenFail(t *testing.T) {
+	validator := ExpressionValidator{Expression: "object.spec.replicas > 3"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestExpressionService)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"DocumentIndex:	0",
+		"Error:",
+		"	no such key: spec",
+	}, diff)
+
+	pass, diff = validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestExpression)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"DocumentIndex:	0",
+		"Expected expression to be true:",
+		"	object.spec.replicas > 3",
+		"Actual:",
+		"	kind: Deployment",
+		"	metadata:",
+		"	  labels:",
+		"	    team: platform",
+		"	  name: my-app",
+		"	spec:",
+		"	  minReadySeconds: 5",
+		"	  replicas: 3",
+	}, diff)
+}
+
+func TestExpressionValidatorWhenNegativeAndOk(t *testing.T) {
+	validator := ExpressionValidator{Expression: "has(object.spec) && object.spec.replicas > 3"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:     []common.K8sManifest{makeManifest(docToTestExpression), makeManifest(docToTestExpressionService)},
+		Negative: true,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestExpressionValidatorWhenInvalidExpression(t *testing.T) {
+	validator := ExpressionValidator{Expression: "object.spec.replicas >"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestExpression)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, "Error:", diff[0])
+	assert.Contains(t, diff[1], "Syntax error")
+}
+
+func TestExpressionValidatorWhenNoBoolResult(t *testing.T) {
+	validator := ExpressionValidator{Expression: "'replicas'"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestExpression)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Error:",
+		"	expected expression to return a bool, got string",
+	}, diff)
+}
+
+func TestExpressionValidatorWhenExpressionEmpty(t *testing.T) {
+	validator := ExpressionValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestExpression)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Error:",
+		"	expected field 'expression' to be filled",
+	}, diff)
+}
@@ -0,0 +1,210 @@
+package validators
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+	"github.com/helm-unittest/helm-unittest/pkg/unittest/valueutils"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// defaultImageRegistry the registry of images without a registry, like nginx:1.27.
+	defaultImageRegistry = "docker.io"
+	// defaultImageNamespace the namespace of official images on the default registry.
+	defaultImageNamespace = "library/"
+	// latestImageTag the tag used when an image has neither a tag nor a digest.
+	latestImageTag = "latest"
+)
+
+// imageReference the parts of a container image reference, like registry.example.com/team/app:1.0@sha256:...
+type imageReference struct {
+	Registry   string
+	Repository string
+	Tag        string
+	Digest     string
+}
+
+// parseImageReference parses the image reference, using the same defaults as container runtimes,
+// so nginx is the repository library/nginx of the docker.io registry.
+func parseImageReference(image string) (imageReference, error) {
+	reference := imageReference{}
+	name := strings.TrimSpace(image)
+
+	if before, after, found := strings.Cut(name, "@"); found {
+		name, reference.Digest = before, after
+	}
+	if index := strings.LastIndex(name, ":"); index > strings.LastIndex(name, "/") {
+		name, reference.Tag = name[:index], name[index+1:]
+	}
+	if name == "" || reference.Digest == "" && strings.Contains(image, "@") {
+		return reference, fmt.Errorf("invalid image reference '%s'", image)
+	}
+
+	registry, repository, found := strings.Cut(name, "/")
+	if !found || (!strings.ContainsAny(registry, ".:") && registry != "localhost") {
+		registry, repository = defaultImageRegistry, name
+	}
+	if registry == "index."+defaultImageRegistry {
+		registry = defaultImageRegistry
+	}
+	if registry == defaultImageRegistry && !strings.Contains(repository, "/") {
+		repository = defaultImageNamespace + repository
+	}
+
+	reference.Registry = registry
+	reference.Repository = repository
+	return reference, nil
+}
+
+// ImageValidator validate the container images at Path, or else the images of all (init and ephemeral) containers.
+// Every image should be from the Registry and Repository, with the Tag, have a digest when RequireDigest is set
+// and not use the latest tag when ForbidLatest is set.
+type ImageValidator struct {
+	Path          string
+	Registry      string
+	Repository    string
+	Tag           string
+	RequireDigest bool
+	ForbidLatest  bool
+}
+
+func (v ImageValidator) failInfo(actual []string, manifestIndex int, not bool) []string {
+	expected := v.expected()
+
+	log.WithField("validator", "image").Debugln("expected image:", expected)
+	log.WithField("validator", "image").Debugln("actual images:", actual)
+
+	return splitInfof(
+		setFailFormat(not, false, true, false, " images to match"),
+		manifestIndex,
+		-1,
+		expected,
+		strings.Join(actual, "\n"),
+	)
+}
+
+// expected describes the expectations of the images.
+func (v ImageValidator) expected() string {
+	expected := make([]string, 0)
+	if v.Registry != "" {
+		expected = append(expected, fmt.Sprintf("registry: %s", v.Registry))
+	}
+	if v.Repository != "" {
+		expected = append(expected, fmt.Sprintf("repository: %s", v.Repository))
+	}
+	if v.Tag != "" {
+		expected = append(expected, fmt.Sprintf("tag: %s", v.Tag))
+	}
+	if v.RequireDigest {
+		expected = append(expected, "requireDigest: true")
+	}
+	if v.ForbidLatest {
+		expected = append(expected, "forbidLatest: true")
+	}
+	return strings.Join(expected, "\n")
+}
+
+// mismatches returns the expectations the image reference does not meet.
+func (v ImageValidator) mismatches(reference imageReference) []string {
+	mismatches := make([]string, 0)
+	if v.Registry != "" && v.Registry != reference.Registry {
+		mismatches = append(mismatches, fmt.Sprintf("registry is '%s'", reference.Registry))
+	}
+	if v.Repository != "" && v.Repository != reference.Repository &&
+		(reference.Registry != defaultImageRegistry || defaultImageNamespace+v.Repository != reference.Repository) {
+		mismatches = append(mismatches, fmt.Sprintf("repository is '%s'", reference.Repository))
+	}
+	if v.Tag != "" && v.Tag != reference.Tag {
+		mismatches = append(mismatches, fmt.Sprintf("tag is '%s'", reference.Tag))
+	}
+	if v.RequireDigest && reference.Digest == "" {
+		mismatches = append(mismatches, "digest is missing")
+	}
+	if v.ForbidLatest && (reference.Tag == latestImageTag || reference.Tag == "" && reference.Digest == "") {
+		mismatches = append(mismatches, "tag is latest")
+	}
+	return mismatches
+}
+
+// images returns the images at Path, or else the images of all containers of the pod template.
+func (v ImageValidator) images(manifest common.K8sManifest) ([]any, error) {
+	if v.Path != "" {
+		return valueutils.GetValueOfSetPath(manifest, v.Path)
+	}
+
+	images := make([]any, 0)
+	if _, podSpec, ok := podTemplate(manifest); ok {
+		for _, container := range podContainers(podSpec) {
+			if image, ok := nestedMap(container)["image"]; ok {
+				images = append(images, image)
+			}
+		}
+	}
+	return images, nil
+}
+
+func (v ImageValidator) validateManifest(manifest common.K8sManifest, manifestIndex int, context *ValidateContext) (bool, []string, int) {
+	images, err := v.images(manifest)
+	if err != nil {
+		return false, splitInfof(errorFormat, manifestIndex, -1, err.Error()), 0
+	}
+
+	failures := make([]string, 0)
+	for _, image := range images {
+		name, ok := image.(string)
+		if !ok {
+			return false, splitInfof(errorFormat, manifestIndex, -1, fmt.Sprintf("expect image to be a string, got:\n%s", common.TrustedMarshalYAML(image))), 0
+		}
+
+		reference, err := parseImageReference(name)
+		if err != nil {
+			return false, splitInfof(errorFormat, manifestIndex, -1, err.Error()), 0
+		}
+
+		mismatches := v.mismatches(reference)
+		if (len(mismatches) == 0) == context.Negative {
+			if len(mismatches) > 0 {
+				name = fmt.Sprintf("%s: %s", name, strings.Join(mismatches, ", "))
+			}
+			failures = append(failures, name)
+		}
+	}
+
+	if len(failures) > 0 {
+		return false, v.failInfo(failures, manifestIndex, context.Negative), len(images)
+	}
+	return true, []string{}, len(images)
+}
+
+// Validate implement Validatable
+func (v ImageValidator) Validate(context *ValidateContext) (bool, []string) {
+	if v.expected() == "" {
+		return false, splitInfof(errorFormat, -1, -1, "expected at least one of field 'registry', 'repository', 'tag', 'requireDigest' or 'forbidLatest' to be filled")
+	}
+
+	manifests := context.getManifests()
+
+	validateSuccess := true
+	validateErrors := make([]string, 0)
+	imageCount := 0
+
+	for manifestIndex, manifest := range manifests {
+		manifestSuccess, manifestErrors, manifestImageCount := v.validateManifest(manifest, manifestIndex, context)
+		validateErrors = append(validateErrors, manifestErrors...)
+		validateSuccess = validateSuccess && manifestSuccess
+		imageCount += manifestImageCount
+
+		if !validateSuccess && context.FailFast {
+			break
+		}
+	}
+
+	if imageCount == 0 && validateSuccess && !context.Negative {
+		validateSuccess = false
+		validateErrors = append(validateErrors, v.failInfo([]string{"no images found"}, -1, context.Negative)...)
+	}
+
+	return validateSuccess, validateErrors
+}
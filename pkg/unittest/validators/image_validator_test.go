@@ -0,0 +1,177 @@
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+	. "github.com/helm-unittest/helm-unittest/pkg/unittest/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+var docToTestImage = `
+kind: Deployment
+metadata:
+  name: my-app
+spec:
+  template:
+    spec:
+      initContainers:
+        - name: init
+          image: registry.example.com/tools/busybox:1.36
+      containers:
+        - name: app
+          image: registry.example.com/team/app:2.1.0@sha256:4f6c1b6f6b0a4f5d2f1f5f1e4d0f3b1b2c1f4d6b7e9a8c0d1e2f3a4b5c6d7e8f
+`
+
+var docToTestImageDefaults = `
+kind: Pod
+metadata:
+  name: my-pod
+spec:
+  containers:
+    - name: web
+      image: nginx
+  ephemeralContainers:
+    - name: debug
+      image: localhost:5000/debug:latest
+`
+
+func TestImageValidatorWhenOk(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator ImageValidator
+	}{
+		{name: "registry", validator: ImageValidator{Registry: "registry.example.com"}},
+		{name: "forbid latest", validator: ImageValidator{ForbidLatest: true}},
+		{name: "repository at path", validator: ImageValidator{Path: "spec.template.spec.containers[0].image", Repository: "team/app", Tag: "2.1.0", RequireDigest: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pass, diff := tt.validator.Validate(&ValidateContext{
+				Docs: []common.K8sManifest{makeManifest(docToTestImage)},
+			})
+
+			assert.True(t, pass)
+			assert.Equal(t, []string{}, diff)
+		})
+	}
+}
+
+func TestImageValidatorWhenDefaultRegistryOk(t *testing.T) {
+	validator := ImageValidator{
+		Path:       "spec.containers[0].image",
+		Registry:   "docker.io",
+		Repository: "nginx",
+	}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestImageDefaults)},
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestImageValidatorWhenFail(t *testing.T) {
+	validator := ImageValidator{Registry: "registry.example.com", ForbidLatest: true}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestImage), makeManifest(docToTestImageDefaults)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"DocumentIndex:	1",
+		"Expected images to match:",
+		"	registry: registry.example.com",
+		"	forbidLatest: true",
+		"Actual:",
+		"	nginx: registry is 'docker.io', tag is latest",
+		"	localhost:5000/debug:latest: registry is 'localhost:5000', tag is latest",
+	}, diff)
+}
+
+func TestImageValidatorWhenRequireDigestFail(t *testing.T) {
+	validator := ImageValidator{RequireDigest: true}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestImage)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"DocumentIndex:	0",
+		"Expected images to match:",
+		"	requireDigest: true",
+		"Actual:",
+		"	registry.example.com/tools/busybox:1.36: digest is missing",
+	}, diff)
+}
+
+func TestImageValidatorWhenNegativeAndOk(t *testing.T) {
+	validator := ImageValidator{Tag: "latest"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:     []common.K8sManifest{makeManifest(docToTestImage)},
+		Negative: true,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestImageValidatorWhenNegativeAndFail(t *testing.T) {
+	validator := ImageValidator{Registry: "docker.io"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:     []common.K8sManifest{makeManifest(docToTestImageDefaults)},
+		Negative: true,
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"DocumentIndex:	0",
+		"Expected NOT images to match:",
+		"	registry: docker.io",
+		"Actual:",
+		"	nginx",
+	}, diff)
+}
+
+func TestImageValidatorWhenInvalidImage(t *testing.T) {
+	validator := ImageValidator{Path: "metadata.name", ForbidLatest: true}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest("metadata:\n  name: app@\n")},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"DocumentIndex:	0",
+		"Error:",
+		"	invalid image reference 'app@'",
+	}, diff)
+}
+
+func TestImageValidatorWhenNoExpectations(t *testing.T) {
+	validator := ImageValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestImage)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Error:",
+		"	expected at least one of field 'registry', 'repository', 'tag', 'requireDigest' or 'forbidLatest' to be filled",
+	}, diff)
+}
+
+func TestImageValidatorWhenNoImagesFail(t *testing.T) {
+	validator := ImageValidator{ForbidLatest: true}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest("kind: Service\n")},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Expected images to match:",
+		"	forbidLatest: true",
+		"Actual:",
+		"	no images found",
+	}, diff)
+}
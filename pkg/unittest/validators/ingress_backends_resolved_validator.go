@@ -0,0 +1,118 @@
+package validators
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+)
+
+// IngressBackendsResolvedValidator validate whether every backend of an Ingress
+// points at an existing port of a Service, rendered in the release or existing in the cluster.
+// Backends referring to a resource instead of a service are not validated.
+type IngressBackendsResolvedValidator struct{}
+
+type ingressBackend struct {
+	serviceName string
+	portName    string
+	portNumber  any
+}
+
+func (b ingressBackend) String() string {
+	if b.portName != "" {
+		return fmt.Sprintf("%s:%s", b.serviceName, b.portName)
+	}
+	return fmt.Sprintf("%s:%v", b.serviceName, b.portNumber)
+}
+
+// toBackend converts the backend definition, supporting both the networking.k8s.io/v1
+// and the legacy serviceName/servicePort definitions.
+func (v IngressBackendsResolvedValidator) toBackend(backend map[string]any) (ingressBackend, bool) {
+	if service := nestedMap(backend, "service"); service != nil {
+		return ingressBackend{
+			serviceName: nestedString(service, "name"),
+			portName:    nestedString(service, "port", "name"),
+			portNumber:  nestedMap(service, "port")["number"],
+		}, true
+	}
+
+	if serviceName := nestedString(backend, "serviceName"); serviceName != "" {
+		if portName, ok := backend["servicePort"].(string); ok {
+			return ingressBackend{serviceName: serviceName, portName: portName}, true
+		}
+		return ingressBackend{serviceName: serviceName, portNumber: backend["servicePort"]}, true
+	}
+
+	return ingressBackend{}, false
+}
+
+// backends returns all service backends of the ingress.
+func (v IngressBackendsResolvedValidator) backends(ingress common.K8sManifest) []ingressBackend {
+	definitions := make([]map[string]any, 0)
+	if defaultBackend := nestedMap(ingress, "spec", "defaultBackend"); defaultBackend != nil {
+		definitions = append(definitions, defaultBackend)
+	}
+	if legacyBackend := nestedMap(ingress, "spec", "backend"); legacyBackend != nil {
+		definitions = append(definitions, legacyBackend)
+	}
+	for _, rule := range nestedSlice(ingress, "spec", "rules") {
+		for _, path := range nestedSlice(rule, "http", "paths") {
+			definitions = append(definitions, nestedMap(path, "backend"))
+		}
+	}
+
+	backends := make([]ingressBackend, 0, len(definitions))
+	for _, definition := range definitions {
+		if backend, ok := v.toBackend(definition); ok {
+			backends = append(backends, backend)
+		}
+	}
+	return backends
+}
+
+// hasPort checks if one of the services exposes the port of the backend.
+func (v IngressBackendsResolvedValidator) hasPort(services []common.K8sManifest, backend ingressBackend) bool {
+	for _, service := range services {
+		for _, port := range nestedSlice(service, "spec", "ports") {
+			portDef, _ := port.(map[string]any)
+			if backend.portName != "" && nestedString(portDef, "name") == backend.portName {
+				return true
+			}
+			if backend.portName == "" && fmt.Sprint(portDef["port"]) == fmt.Sprint(backend.portNumber) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Validate implement Validatable
+func (v IngressBackendsResolvedValidator) Validate(context *ValidateContext) (bool, []string) {
+	objects := context.allObjects()
+	releaseNamespace := context.releaseNamespace()
+
+	problems := make([]string, 0)
+	for _, manifest := range context.getManifests() {
+		if nestedString(manifest, "kind") != "Ingress" {
+			continue
+		}
+
+		for _, backend := range v.backends(manifest) {
+			services := findResources(objects, "Service", manifestNamespace(manifest, releaseNamespace), backend.serviceName, releaseNamespace)
+			if len(services) == 0 {
+				problems = append(problems, fmt.Sprintf("%s backend %s: Service/%s not found", resourceIdentifier(manifest, releaseNamespace), backend, backend.serviceName))
+			} else if !v.hasPort(services, backend) {
+				problems = append(problems, fmt.Sprintf("%s backend %s: port not found", resourceIdentifier(manifest, releaseNamespace), backend))
+			}
+		}
+	}
+
+	log.WithField("validator", "ingress_backends_resolved").Debugln("unresolved backends:", problems)
+
+	if (len(problems) == 0) == context.Negative {
+		return false, referencesFailInfo(context.Negative, "ingress backends to be resolved", problems)
+	}
+
+	return true, []string{}
+}
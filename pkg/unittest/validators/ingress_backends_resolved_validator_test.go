@@ -0,0 +1,143 @@
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+	. "github.com/helm-unittest/helm-unittest/pkg/unittest/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+var docToTestIngressBackendsResolvedIngress = `
+kind: Ingress
+metadata:
+  name: web
+spec:
+  defaultBackend:
+    service:
+      name: web
+      port:
+        name: http
+  rules:
+    - host: example.com
+      http:
+        paths:
+          - path: /
+            backend:
+              service:
+                name: web
+                port:
+                  number: 80
+          - path: /api
+            backend:
+              service:
+                name: api
+                port:
+                  number: 8080
+          - path: /static
+            backend:
+              resource:
+                kind: StorageBucket
+                name: static
+`
+
+var docToTestIngressBackendsResolvedWeb = `
+kind: Service
+metadata:
+  name: web
+spec:
+  ports:
+    - name: http
+      port: 80
+`
+
+var docToTestIngressBackendsResolvedApi = `
+kind: Service
+metadata:
+  name: api
+spec:
+  ports:
+    - name: http
+      port: 80
+`
+
+func TestIngressBackendsResolvedValidatorWhenOk(t *testing.T) {
+	validator := IngressBackendsResolvedValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{
+			makeManifest(docToTestIngressBackendsResolvedIngress),
+			makeManifest(docToTestIngressBackendsResolvedWeb),
+		},
+		ClusterObjects: []common.K8sManifest{
+			makeManifest(`
+kind: Service
+metadata:
+  name: api
+spec:
+  ports:
+    - port: 8080
+`),
+		},
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestIngressBackendsResolvedValidatorWhenLegacyOk(t *testing.T) {
+	validator := IngressBackendsResolvedValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{
+			makeManifest(`
+kind: Ingress
+metadata:
+  name: web
+spec:
+  backend:
+    serviceName: web
+    servicePort: http
+  rules:
+    - http:
+        paths:
+          - backend:
+              serviceName: web
+              servicePort: 80
+`),
+			makeManifest(docToTestIngressBackendsResolvedWeb),
+		},
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestIngressBackendsResolvedValidatorWhenFail(t *testing.T) {
+	validator := IngressBackendsResolvedValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{
+			makeManifest(docToTestIngressBackendsResolvedIngress),
+			makeManifest(docToTestIngressBackendsResolvedApi),
+		},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Expected ingress backends to be resolved, unresolved:",
+		"	Ingress/web backend web:http: Service/web not found",
+		"	Ingress/web backend web:80: Service/web not found",
+		"	Ingress/web backend api:8080: port not found",
+	}, diff)
+}
+
+func TestIngressBackendsResolvedValidatorWhenNegativeAndOk(t *testing.T) {
+	validator := IngressBackendsResolvedValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{
+			makeManifest(docToTestIngressBackendsResolvedIngress),
+		},
+		Negative: true,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
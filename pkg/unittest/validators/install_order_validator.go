@@ -0,0 +1,76 @@
+package validators
+
+import (
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+)
+
+// InstallOrderValidator validate whether the Resources are installed in the given order.
+// A resource is referenced as Kind, Kind/name or Kind/namespace/name.
+type InstallOrderValidator struct {
+	Resources []string
+}
+
+func (v InstallOrderValidator) failInfo(manifests []common.K8sManifest, releaseNamespace string, not bool) []string {
+	expected := strings.Join(v.Resources, "\n")
+	actualResources := make([]string, 0, len(manifests))
+	for _, manifest := range manifests {
+		actualResources = append(actualResources, resourceIdentifier(manifest, releaseNamespace))
+	}
+	actual := strings.Join(actualResources, "\n")
+
+	log.WithField("validator", "install_order").Debugln("expected content:", expected)
+	log.WithField("validator", "install_order").Debugln("actual content:", actual)
+
+	return splitInfof(
+		setFailFormat(not, false, true, false, " install order"),
+		-1,
+		-1,
+		expected,
+		actual,
+	)
+}
+
+// matchResource checks if the manifest is referenced by the resource,
+// a manifest without namespace is in the release namespace.
+func (v InstallOrderValidator) matchResource(resource string, manifest common.K8sManifest, releaseNamespace string) bool {
+	return matchResourceReference(resource, manifest, releaseNamespace)
+}
+
+// inOrder checks if all resources are found in the manifests, in the given order.
+func (v InstallOrderValidator) inOrder(manifests []common.K8sManifest, releaseNamespace string) bool {
+	position := 0
+	for _, resource := range v.Resources {
+		found := -1
+		for idx := position; idx < len(manifests); idx++ {
+			if v.matchResource(resource, manifests[idx], releaseNamespace) {
+				found = idx
+				break
+			}
+		}
+
+		if found == -1 {
+			return false
+		}
+		position = found + 1
+	}
+	return true
+}
+
+// Validate implement Validatable
+func (v InstallOrderValidator) Validate(context *ValidateContext) (bool, []string) {
+	if len(v.Resources) == 0 {
+		return false, splitInfof(errorFormat, -1, -1, "expected field 'resources' to be filled")
+	}
+
+	manifests := context.getManifests()
+
+	if v.inOrder(manifests, context.releaseNamespace()) == context.Negative {
+		return false, v.failInfo(manifests, context.releaseNamespace(), context.Negative)
+	}
+
+	return true, []string{}
+}
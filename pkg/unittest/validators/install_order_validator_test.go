@@ -0,0 +1,129 @@
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+	. "github.com/helm-unittest/helm-unittest/pkg/unittest/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+var docsToTestInstallOrder = []common.K8sManifest{
+	makeManifest(`
+kind: ServiceAccount
+metadata:
+  name: foo
+`),
+	makeManifest(`
+kind: ConfigMap
+metadata:
+  name: foo-config
+  namespace: bar
+`),
+	makeManifest(`
+kind: Deployment
+metadata:
+  name: foo
+`),
+}
+
+func TestInstallOrderValidatorWhenOk(t *testing.T) {
+	validator := InstallOrderValidator{Resources: []string{"ServiceAccount", "ConfigMap/foo-config", "Deployment/foo"}}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: docsToTestInstallOrder,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestInstallOrderValidatorWithNamespaceWhenOk(t *testing.T) {
+	validator := InstallOrderValidator{Resources: []string{"ConfigMap/bar/foo-config", "Deployment"}}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: docsToTestInstallOrder,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestInstallOrderValidatorWithReleaseNamespaceWhenOk(t *testing.T) {
+	validator := InstallOrderValidator{Resources: []string{"ServiceAccount/bar/foo", "ConfigMap/bar/foo-config", "Deployment/bar/foo"}}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:          docsToTestInstallOrder,
+		RenderContext: RenderContext{Release: map[string]any{"Namespace": "bar"}},
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestInstallOrderValidatorWhenNegativeAndOk(t *testing.T) {
+	validator := InstallOrderValidator{Resources: []string{"Deployment", "ServiceAccount"}}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:     docsToTestInstallOrder,
+		Negative: true,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestInstallOrderValidatorWhenFail(t *testing.T) {
+	validator := InstallOrderValidator{Resources: []string{"Deployment", "ConfigMap"}}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: docsToTestInstallOrder,
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Expected install order:",
+		"	Deployment",
+		"	ConfigMap",
+		"Actual:",
+		"	ServiceAccount/foo",
+		"	ConfigMap/bar/foo-config",
+		"	Deployment/foo",
+	}, diff)
+}
+
+func TestInstallOrderValidatorWhenResourceNotFoundFail(t *testing.T) {
+	validator := InstallOrderValidator{Resources: []string{"ConfigMap/unknown"}}
+	pass, _ := validator.Validate(&ValidateContext{
+		Docs: docsToTestInstallOrder,
+	})
+
+	assert.False(t, pass)
+}
+
+func TestInstallOrderValidatorWhenNegativeAndFail(t *testing.T) {
+	validator := InstallOrderValidator{Resources: []string{"ServiceAccount", "Deployment"}}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:     docsToTestInstallOrder,
+		Negative: true,
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Expected NOT install order:",
+		"	ServiceAccount",
+		"	Deployment",
+		"Actual:",
+		"	ServiceAccount/foo",
+		"	ConfigMap/bar/foo-config",
+		"	Deployment/foo",
+	}, diff)
+}
+
+func TestInstallOrderValidatorWhenNoResourcesFail(t *testing.T) {
+	validator := InstallOrderValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: docsToTestInstallOrder,
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Error:",
+		"	expected field 'resources' to be filled",
+	}, diff)
+}
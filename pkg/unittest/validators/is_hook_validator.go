@@ -0,0 +1,143 @@
+package validators
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+	log "github.com/sirupsen/logrus"
+)
+
+// IsHookValidator validate manifest is a Helm hook.
+// When Events are given, the hook must be defined for all the Events.
+// When Weight is given, the hook weight must be equal.
+// When DeletePolicy is given, the hook must have all the delete policies, defined as string or list of strings.
+type IsHookValidator struct {
+	Events       []string
+	Weight       *int
+	DeletePolicy any
+}
+
+// deletePolicies returns the expected delete policies.
+func (v IsHookValidator) deletePolicies() ([]string, error) {
+	switch policy := v.DeletePolicy.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{policy}, nil
+	case []any:
+		policies := make([]string, 0, len(policy))
+		for _, p := range policy {
+			policies = append(policies, fmt.Sprintf("%v", p))
+		}
+		return policies, nil
+	default:
+		return nil, fmt.Errorf("expected field 'deletePolicy' to be a string or a list of strings")
+	}
+}
+
+// expected returns the expected hook definition, for showing in the failure information.
+func (v IsHookValidator) expected(deletePolicies []string) map[string]any {
+	expected := map[string]any{}
+	if len(v.Events) > 0 {
+		expected["events"] = v.Events
+	}
+	if v.Weight != nil {
+		expected["weight"] = *v.Weight
+	}
+	if len(deletePolicies) > 0 {
+		expected["deletePolicy"] = deletePolicies
+	}
+	return expected
+}
+
+// actual returns the hook definition of the manifest, for showing in the failure information.
+func (v IsHookValidator) actual(manifest common.K8sManifest) any {
+	if !common.IsHook(manifest) {
+		return "not a hook"
+	}
+	return map[string]any{
+		"events":       common.HookEvents(manifest),
+		"weight":       common.HookWeight(manifest),
+		"deletePolicy": common.HookDeletePolicies(manifest),
+	}
+}
+
+func (v IsHookValidator) failInfo(expected, actual any, manifestIndex int, not bool) []string {
+	expectedYAML := common.TrustedMarshalYAML(expected)
+	actualYAML := common.TrustedMarshalYAML(actual)
+	customMessage := " to be hook"
+
+	log.WithField("validator", "is_hook").Debugln("expected content:", expectedYAML)
+	log.WithField("validator", "is_hook").Debugln("actual content:", actualYAML)
+
+	return splitInfof(
+		setFailFormat(not, false, true, false, customMessage),
+		manifestIndex,
+		-1,
+		expectedYAML,
+		actualYAML,
+	)
+}
+
+// matches checks if the manifest is a hook, matching the expected definition.
+func (v IsHookValidator) matches(manifest common.K8sManifest, deletePolicies []string) bool {
+	if !common.IsHook(manifest) {
+		return false
+	}
+
+	events := common.HookEvents(manifest)
+	for _, event := range v.Events {
+		if !slices.Contains(events, event) {
+			return false
+		}
+	}
+
+	if v.Weight != nil && *v.Weight != common.HookWeight(manifest) {
+		return false
+	}
+
+	policies := common.HookDeletePolicies(manifest)
+	for _, policy := range deletePolicies {
+		if !slices.Contains(policies, policy) {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate implement Validatable
+func (v IsHookValidator) Validate(context *ValidateContext) (bool, []string) {
+	deletePolicies, err := v.deletePolicies()
+	if err != nil {
+		return false, splitInfof(errorFormat, -1, -1, err.Error())
+	}
+
+	manifests := context.getManifests()
+
+	validateSuccess := false
+	validateErrors := make([]string, 0)
+
+	for manifestIndex, manifest := range manifests {
+		if v.matches(manifest, deletePolicies) == context.Negative {
+			validateSuccess = false
+			errorMessage := v.failInfo(v.expected(deletePolicies), v.actual(manifest), manifestIndex, context.Negative)
+			validateErrors = append(validateErrors, errorMessage...)
+			if context.FailFast {
+				break
+			}
+			continue
+		}
+
+		validateSuccess = determineSuccess(manifestIndex, validateSuccess, true)
+	}
+
+	if len(manifests) == 0 && !context.Negative {
+		errorMessage := v.failInfo(v.expected(deletePolicies), "no manifest found", -1, context.Negative)
+		validateErrors = append(validateErrors, errorMessage...)
+	} else if len(manifests) == 0 && context.Negative {
+		validateSuccess = true
+	}
+
+	return validateSuccess, validateErrors
+}
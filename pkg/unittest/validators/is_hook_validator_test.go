@@ -0,0 +1,139 @@
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+	. "github.com/helm-unittest/helm-unittest/pkg/unittest/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+var docToTestIsHook = `
+kind: Job
+metadata:
+  name: migrate
+  annotations:
+    helm.sh/hook: pre-install,pre-upgrade
+    helm.sh/hook-weight: "5"
+    helm.sh/hook-delete-policy: before-hook-creation,hook-succeeded
+`
+
+var docToTestIsNoHook = `
+kind: ConfigMap
+metadata:
+  name: config
+`
+
+func TestIsHookValidatorWhenOk(t *testing.T) {
+	weight := 5
+	validator := IsHookValidator{
+		Events:       []string{"pre-upgrade"},
+		Weight:       &weight,
+		DeletePolicy: "hook-succeeded",
+	}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestIsHook)},
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestIsHookValidatorWhenNoPropertiesOk(t *testing.T) {
+	validator := IsHookValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestIsHook)},
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestIsHookValidatorWhenDeletePolicyListOk(t *testing.T) {
+	validator := IsHookValidator{
+		DeletePolicy: []any{"hook-succeeded", "before-hook-creation"},
+	}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestIsHook)},
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestIsHookValidatorWhenWeightFail(t *testing.T) {
+	weight := 1
+	validator := IsHookValidator{
+		Events: []string{"pre-install"},
+		Weight: &weight,
+	}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestIsHook)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"DocumentIndex:	0",
+		"Expected to be hook:",
+		"	events:",
+		"	  - pre-install",
+		"	weight: 1",
+		"Actual:",
+		"	deletePolicy:",
+		"	  - before-hook-creation",
+		"	  - hook-succeeded",
+		"	events:",
+		"	  - pre-install",
+		"	  - pre-upgrade",
+		"	weight: 5",
+	}, diff)
+}
+
+func TestIsHookValidatorWhenNoHookFail(t *testing.T) {
+	validator := IsHookValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestIsNoHook)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"DocumentIndex:	0",
+		"Expected to be hook:",
+		"	{}",
+		"Actual:",
+		"	not a hook",
+	}, diff)
+}
+
+func TestIsHookValidatorWhenNegativeAndOk(t *testing.T) {
+	validator := IsHookValidator{Events: []string{"test"}}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:     []common.K8sManifest{makeManifest(docToTestIsHook), makeManifest(docToTestIsNoHook)},
+		Negative: true,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestIsHookValidatorWhenInvalidDeletePolicy(t *testing.T) {
+	validator := IsHookValidator{DeletePolicy: 1}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestIsHook)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Error:",
+		"	expected field 'deletePolicy' to be a string or a list of strings",
+	}, diff)
+}
+
+func TestIsHookValidatorWhenNoManifestFail(t *testing.T) {
+	validator := IsHookValidator{}
+	pass, _ := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{},
+	})
+
+	assert.False(t, pass)
+}
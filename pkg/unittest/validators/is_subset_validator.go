@@ -8,10 +8,18 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-// IsSubsetValidator validate whether value of Path contains Content
+// IsSubsetValidator validate whether value of Path contains Content.
+// Lists are compared in any order when IgnoreOrder is set, the values at the IgnorePaths,
+// relative to the value of Path, are left out of the comparison.
 type IsSubsetValidator struct {
-	Path    string
-	Content any
+	Path        string
+	Content     any
+	IgnoreOrder bool
+	IgnorePaths []string
+}
+
+func (v IsSubsetValidator) compare() structuralCompare {
+	return structuralCompare{IgnoreOrder: v.IgnoreOrder, IgnorePaths: v.IgnorePaths}
 }
 
 func (v IsSubsetValidator) failInfo(actual any, manifestIndex, valueIndex int, not bool) []string {
@@ -44,13 +52,22 @@ func (v IsSubsetValidator) validateManifest(manifest common.K8sManifest, manifes
 	manifestValidateSuccess := (len(actual) == 0 && context.Negative)
 	var manifestValidateErrors []string
 
+	content, err := v.compare().normalize(v.Content)
+	if err != nil {
+		return false, splitInfof(errorFormat, manifestIndex, -1, err.Error())
+	}
+
 	for actualIndex, singleActual := range actual {
 		var errorMessage []string
+		singleActual, err = v.compare().normalize(singleActual)
+		if err != nil {
+			return false, splitInfof(errorFormat, manifestIndex, actualIndex, err.Error())
+		}
 		actualMap, actualOk := singleActual.(map[string]any)
-		contentMap, contentOk := v.Content.(map[string]any)
+		contentMap, contentOk := content.(map[string]any)
 
 		if actualOk && contentOk {
-			found := validateSubset(actualMap, contentMap)
+			found := v.compare().subset(actualMap, contentMap)
 
 			if found == context.Negative {
 				errorMessage = v.failInfo(singleActual, manifestIndex, actualIndex, context.Negative)
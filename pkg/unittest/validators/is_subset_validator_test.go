@@ -21,8 +21,8 @@ func TestIsSubsetValidatorWhenOk(t *testing.T) {
 	manifest := makeManifest(docToTestIsSubset)
 
 	validator := IsSubsetValidator{
-		"a.b",
-		map[string]any{"d": "foo bar", "x": "baz"}}
+		Path:    "a.b",
+		Content: map[string]any{"d": "foo bar", "x": "baz"}}
 
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs: []common.K8sManifest{manifest},
@@ -36,8 +36,8 @@ func TestIsSubsetValidatorWhenNegativeAndOk(t *testing.T) {
 	manifest := makeManifest(docToTestIsSubset)
 
 	validator := IsSubsetValidator{
-		"a.b",
-		map[string]any{"d": "hello bar", "c": "hello world"}}
+		Path:    "a.b",
+		Content: map[string]any{"d": "hello bar", "c": "hello world"}}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs:     []common.K8sManifest{manifest},
 		Negative: true,
@@ -53,8 +53,8 @@ func TestIsSubsetValidatorWhenFail(t *testing.T) {
 	log.SetLevel(log.DebugLevel)
 
 	validator := IsSubsetValidator{
-		"a.b",
-		map[string]any{"e": "bar bar"},
+		Path:    "a.b",
+		Content: map[string]any{"e": "bar bar"},
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs: []common.K8sManifest{manifest},
@@ -85,8 +85,8 @@ a:
 	manifests := []common.K8sManifest{manifest1, manifest2}
 
 	validator := IsSubsetValidator{
-		"a.b",
-		map[string]any{"d": "foo bar"},
+		Path:    "a.b",
+		Content: map[string]any{"d": "foo bar"},
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs: manifests,
@@ -109,8 +109,8 @@ func TestIsSubsetValidatorMultiManifestWhenBothFail(t *testing.T) {
 	manifests := []common.K8sManifest{manifest1, manifest1}
 
 	validator := IsSubsetValidator{
-		"a.b",
-		map[string]any{"e": "foo bar"},
+		Path:    "a.b",
+		Content: map[string]any{"e": "foo bar"},
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs: manifests,
@@ -143,8 +143,8 @@ func TestIsSubsetValidatorWhenNegativeAndFail(t *testing.T) {
 	manifest := makeManifest(docToTestIsSubset)
 
 	validator := IsSubsetValidator{
-		"a.b",
-		map[string]any{"d": "foo bar"},
+		Path:    "a.b",
+		Content: map[string]any{"d": "foo bar"},
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs:     []common.K8sManifest{manifest},
@@ -174,7 +174,7 @@ a:
 `
 	manifest := makeManifest(manifestDocNotObject)
 
-	validator := IsSubsetValidator{"a.b.c", common.K8sManifest{"d": "foo bar"}}
+	validator := IsSubsetValidator{Path: "a.b.c", Content: common.K8sManifest{"d": "foo bar"}}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs: []common.K8sManifest{manifest},
 	})
@@ -198,7 +198,7 @@ a:
 `
 	manifest := makeManifest(manifestDocNotObject)
 
-	validator := IsSubsetValidator{"a.b.c", common.K8sManifest{"d": "foo bar"}}
+	validator := IsSubsetValidator{Path: "a.b.c", Content: common.K8sManifest{"d": "foo bar"}}
 	pass, diff := validator.Validate(&ValidateContext{
 		FailFast: true,
 		Docs:     []common.K8sManifest{manifest, manifest},
@@ -217,7 +217,7 @@ a:
 func TestIsSubsetValidatorWhenInvalidPath(t *testing.T) {
 	manifest := makeManifest("a: error")
 
-	validator := IsSubsetValidator{"a[b]", common.K8sManifest{"d": "foo bar"}}
+	validator := IsSubsetValidator{Path: "a[b]", Content: common.K8sManifest{"d": "foo bar"}}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs: []common.K8sManifest{manifest},
 	})
@@ -233,7 +233,7 @@ func TestIsSubsetValidatorWhenInvalidPath(t *testing.T) {
 func TestIsSubsetValidatorWhenUnknownPath(t *testing.T) {
 	manifest := makeManifest("a: error")
 
-	validator := IsSubsetValidator{"a[5]", common.K8sManifest{"d": "foo bar"}}
+	validator := IsSubsetValidator{Path: "a[5]", Content: common.K8sManifest{"d": "foo bar"}}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs: []common.K8sManifest{manifest},
 	})
@@ -249,7 +249,7 @@ func TestIsSubsetValidatorWhenUnknownPath(t *testing.T) {
 func TestIsSubsetValidatorWhenUnknownPathNegative(t *testing.T) {
 	manifest := makeManifest("a: error")
 
-	validator := IsSubsetValidator{"a[5]", common.K8sManifest{"d": "foo bar"}}
+	validator := IsSubsetValidator{Path: "a[5]", Content: common.K8sManifest{"d": "foo bar"}}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs:     []common.K8sManifest{manifest},
 		Negative: true,
@@ -262,7 +262,7 @@ func TestIsSubsetValidatorWhenUnknownPathNegative(t *testing.T) {
 func TestIsSubsetValidatorWhenUnknownPathFailFast(t *testing.T) {
 	manifest := makeManifest("a: error")
 
-	validator := IsSubsetValidator{"a[5]", common.K8sManifest{"d": "foo bar"}}
+	validator := IsSubsetValidator{Path: "a[5]", Content: common.K8sManifest{"d": "foo bar"}}
 	pass, diff := validator.Validate(&ValidateContext{
 		FailFast: true,
 		Docs:     []common.K8sManifest{manifest, manifest},
@@ -279,7 +279,7 @@ func TestIsSubsetValidatorWhenUnknownPathFailFast(t *testing.T) {
 func TestIsSubsetValidatorWhenInvalidPathFailFast(t *testing.T) {
 	manifest := makeManifest("a: error")
 
-	validator := IsSubsetValidator{"a[b]", common.K8sManifest{"d": "foo bar"}}
+	validator := IsSubsetValidator{Path: "a[b]", Content: common.K8sManifest{"d": "foo bar"}}
 	pass, diff := validator.Validate(&ValidateContext{
 		FailFast: true,
 		Docs:     []common.K8sManifest{manifest, manifest},
@@ -299,8 +299,8 @@ func TestIsSubsetValidatorWhenFailFast(t *testing.T) {
 	log.SetLevel(log.DebugLevel)
 
 	validator := IsSubsetValidator{
-		"a.b",
-		map[string]any{"e": "bar bar"},
+		Path:    "a.b",
+		Content: map[string]any{"e": "bar bar"},
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		FailFast: true,
@@ -323,8 +323,8 @@ func TestIsSubsetValidatorWhenFailFast(t *testing.T) {
 
 func TestIsSubsetValidatorWhenNoManifestFail(t *testing.T) {
 	validator := IsSubsetValidator{
-		"a.b",
-		map[string]any{"e": "bar bar"},
+		Path:    "a.b",
+		Content: map[string]any{"e": "bar bar"},
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs: []common.K8sManifest{},
@@ -342,8 +342,8 @@ func TestIsSubsetValidatorWhenNoManifestFail(t *testing.T) {
 
 func TestIsSubsetValidatorWhenNoManifestNegativeOk(t *testing.T) {
 	validator := IsSubsetValidator{
-		"a.b",
-		map[string]any{"e": "bar bar"},
+		Path:    "a.b",
+		Content: map[string]any{"e": "bar bar"},
 	}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs:     []common.K8sManifest{},
@@ -353,3 +353,26 @@ func TestIsSubsetValidatorWhenNoManifestNegativeOk(t *testing.T) {
 	assert.True(t, pass)
 	assert.Equal(t, []string{}, diff)
 }
+
+func TestIsSubsetValidatorWhenIgnoreOrderAndIgnorePathsOk(t *testing.T) {
+	manifest := makeManifest(`
+spec:
+  selector:
+    app: web
+    pod-template-hash: 5d8f
+  ports: [443, 80]
+`)
+
+	validator := IsSubsetValidator{
+		Path:        "spec",
+		Content:     map[string]any{"selector": map[string]any{"app": "web"}, "ports": []any{80, 443}},
+		IgnoreOrder: true,
+		IgnorePaths: []string{`selector["pod-template-hash"]`},
+	}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{manifest},
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
@@ -0,0 +1,175 @@
+package validators
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+	"github.com/helm-unittest/helm-unittest/pkg/unittest/valueutils"
+	log "github.com/sirupsen/logrus"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// jsonSchemaContextSeparator separates the elements of the context of a violation,
+// which are converted into a JSON pointer.
+const jsonSchemaContextSeparator = "\x00"
+
+// MatchJsonSchemaValidator validate whether the value at Path matches the JSON schema,
+// defined inline as Schema or in the (JSON or YAML) SchemaFile.
+type MatchJsonSchemaValidator struct {
+	Path       string
+	Schema     any
+	SchemaFile string
+}
+
+func (v MatchJsonSchemaValidator) failInfo(violations []string, manifestIndex, valueIndex int, not bool) []string {
+	expected := v.SchemaFile
+	if expected == "" {
+		expected = common.TrustedMarshalYAML(v.Schema)
+	}
+
+	actual := "no violations"
+	if len(violations) > 0 {
+		actual = strings.Join(violations, "\n")
+	}
+
+	log.WithField("validator", "match_json_schema").Debugln("expected schema:", expected)
+	log.WithField("validator", "match_json_schema").Debugln("actual violations:", actual)
+
+	return splitInfof(
+		setFailFormat(not, true, true, false, " to match JSON schema"),
+		manifestIndex,
+		valueIndex,
+		v.Path,
+		expected,
+		actual,
+	)
+}
+
+// schema loads the JSON schema from the inline Schema or the SchemaFile.
+func (v MatchJsonSchemaValidator) schema(context *ValidateContext) (*gojsonschema.Schema, error) {
+	if (v.Schema == nil) == (v.SchemaFile == "") {
+		return nil, errors.New("expected exactly one of field 'schema' or 'schemaFile' to be filled")
+	}
+
+	schema := v.Schema
+	if v.SchemaFile != "" {
+		content, err := context.readFile(v.SchemaFile)
+		if err != nil {
+			return nil, err
+		}
+		// YAML is a superset of JSON, so both JSON and YAML schema files are supported.
+		if err := common.YmlUnmarshal(string(content), &schema); err != nil {
+			return nil, fmt.Errorf("unable to parse schemaFile %s: %s", v.SchemaFile, err.Error())
+		}
+	} else if inline, ok := v.Schema.(string); ok {
+		if err := common.YmlUnmarshal(inline, &schema); err != nil {
+			return nil, fmt.Errorf("unable to parse schema: %s", err.Error())
+		}
+	}
+
+	return gojsonschema.NewSchema(gojsonschema.NewGoLoader(schema))
+}
+
+// violations validates the value against the schema and returns the violations,
+// prefixed with the JSON pointer to the violating value.
+func (v MatchJsonSchemaValidator) violations(schema *gojsonschema.Schema, value any) ([]string, error) {
+	result, err := schema.Validate(gojsonschema.NewGoLoader(value))
+	if err != nil {
+		return nil, err
+	}
+
+	violations := make([]string, 0, len(result.Errors()))
+	for _, resultError := range result.Errors() {
+		violations = append(violations, fmt.Sprintf("%s: %s", jsonPointer(resultError.Context()), resultError.Description()))
+	}
+	return violations, nil
+}
+
+func (v MatchJsonSchemaValidator) validateManifest(schema *gojsonschema.Schema, manifest common.K8sManifest, manifestIndex int, context *ValidateContext) (bool, []string) {
+	actuals, err := valueutils.GetValueOfSetPath(manifest, v.Path)
+	if err != nil {
+		return false, splitInfof(errorFormat, manifestIndex, -1, err.Error())
+	}
+
+	if len(actuals) == 0 && !context.Negative {
+		return false, splitInfof(errorFormat, manifestIndex, -1, fmt.Sprintf("unknown path %s", v.Path))
+	}
+
+	manifestSuccess := (len(actuals) == 0 && context.Negative)
+	var manifestErrors []string
+
+	for actualIndex, actual := range actuals {
+		violations, err := v.violations(schema, actual)
+		if err != nil {
+			return false, splitInfof(errorFormat, manifestIndex, actualIndex, err.Error())
+		}
+
+		singleSuccess := (len(violations) == 0) != context.Negative
+		if !singleSuccess {
+			manifestErrors = append(manifestErrors, v.failInfo(violations, manifestIndex, actualIndex, context.Negative)...)
+		}
+		manifestSuccess = determineSuccess(actualIndex, manifestSuccess, singleSuccess)
+
+		if !manifestSuccess && context.FailFast {
+			break
+		}
+	}
+
+	return manifestSuccess, manifestErrors
+}
+
+// Validate implement Validatable
+func (v MatchJsonSchemaValidator) Validate(context *ValidateContext) (bool, []string) {
+	verr := validateRequiredField(v.Path, "path")
+	if verr != nil {
+		return false, splitInfof(errorFormat, -1, -1, verr.Error())
+	}
+
+	schema, err := v.schema(context)
+	if err != nil {
+		return false, splitInfof(errorFormat, -1, -1, err.Error())
+	}
+
+	manifests := context.getManifests()
+
+	validateSuccess := false
+	validateErrors := make([]string, 0)
+
+	for idx, manifest := range manifests {
+		manifestSuccess, manifestErrors := v.validateManifest(schema, manifest, idx, context)
+		validateErrors = append(validateErrors, manifestErrors...)
+		validateSuccess = determineSuccess(idx, validateSuccess, manifestSuccess)
+
+		if !validateSuccess && context.FailFast {
+			break
+		}
+	}
+
+	if len(manifests) == 0 && !context.Negative {
+		errorMessage := v.failInfo([]string{"no manifest found"}, -1, -1, context.Negative)
+		validateErrors = append(validateErrors, errorMessage...)
+	} else if len(manifests) == 0 && context.Negative {
+		validateSuccess = true
+	}
+
+	return validateSuccess, validateErrors
+}
+
+// jsonPointer converts the context of a violation, like (root).spec.containers.0, into a JSON pointer.
+// The pointer to the value itself is shown as `/`.
+func jsonPointer(context *gojsonschema.JsonContext) string {
+	elements := strings.Split(context.String(jsonSchemaContextSeparator), jsonSchemaContextSeparator)[1:]
+	if len(elements) == 0 {
+		return "/"
+	}
+
+	escaper := strings.NewReplacer("~", "~0", "/", "~1")
+	var pointer strings.Builder
+	for _, element := range elements {
+		pointer.WriteString("/")
+		pointer.WriteString(escaper.Replace(element))
+	}
+	return pointer.String()
+}
@@ -0,0 +1,264 @@
+package validators_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+	. "github.com/helm-unittest/helm-unittest/pkg/unittest/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+var docToTestMatchJsonSchema = `
+kind: Deployment
+metadata:
+  name: my-app
+spec:
+  template:
+    spec:
+      containers:
+        - name: app
+          resources:
+            limits:
+              cpu: 100m
+              memory: 128Mi
+        - name: sidecar
+          resources:
+            limits:
+              cpu: 50m
+`
+
+var schemaToTestMatchJsonSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"resources": map[string]any{
+			"type":     "object",
+			"required": []any{"limits"},
+			"properties": map[string]any{
+				"limits": map[string]any{
+					"type":     "object",
+					"required": []any{"cpu", "memory"},
+				},
+			},
+		},
+	},
+	"required": []any{"resources"},
+}
+
+func TestMatchJsonSchemaValidatorWhenOk(t *testing.T) {
+	validator := MatchJsonSchemaValidator{
+		Path:   "spec.template.spec.containers[0]",
+		Schema: schemaToTestMatchJsonSchema,
+	}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestMatchJsonSchema)},
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestMatchJsonSchemaValidatorWhenFail(t *testing.T) {
+	validator := MatchJsonSchemaValidator{
+		Path:   "spec.template.spec.containers[*]",
+		Schema: schemaToTestMatchJsonSchema,
+	}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestMatchJsonSchema)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"DocumentIndex:	0",
+		"ValuesIndex:	1",
+		"Path:	spec.template.spec.containers[*]",
+		"Expected to match JSON schema:",
+		"	properties:",
+		"	  resources:",
+		"	    properties:",
+		"	      limits:",
+		"	        required:",
+		"	          - cpu",
+		"	          - memory",
+		"	        type: object",
+		"	    required:",
+		"	      - limits",
+		"	    type: object",
+		"	required:",
+		"	  - resources",
+		"	type: object",
+		"Actual:",
+		"	/resources/limits: memory is required",
+	}, diff)
+}
+
+func TestMatchJsonSchemaValidatorWhenInlineStringSchemaFail(t *testing.T) {
+	validator := MatchJsonSchemaValidator{
+		Path:   "spec.template.spec.containers",
+		Schema: `{"type": "array", "items": {"properties": {"name": {"type": "string", "pattern": "^app"}}}, "maxItems": 1}`,
+	}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestMatchJsonSchema)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"	/1/name: Does not match pattern '^app'",
+		"	/: Array must have at most 1 items",
+	}, diff[len(diff)-2:])
+}
+
+func TestMatchJsonSchemaValidatorWhenSchemaFileOk(t *testing.T) {
+	dir := t.TempDir()
+	schemaFile := `
+type: object
+required: [cpu]
+properties:
+  cpu:
+    type: string
+`
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "limits.schema.yaml"), []byte(schemaFile), 0644))
+
+	validator := MatchJsonSchemaValidator{
+		Path:       "spec.template.spec.containers[*].resources.limits",
+		SchemaFile: "limits.schema.yaml",
+	}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:    []common.K8sManifest{makeManifest(docToTestMatchJsonSchema)},
+		BaseDir: dir,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestMatchJsonSchemaValidatorWhenNegativeAndOk(t *testing.T) {
+	validator := MatchJsonSchemaValidator{
+		Path:   "spec.template.spec.containers[1]",
+		Schema: schemaToTestMatchJsonSchema,
+	}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:     []common.K8sManifest{makeManifest(docToTestMatchJsonSchema)},
+		Negative: true,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestMatchJsonSchemaValidatorWhenNegativeAndFail(t *testing.T) {
+	validator := MatchJsonSchemaValidator{
+		Path:   "metadata.name",
+		Schema: map[string]any{"type": "string"},
+	}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:     []common.K8sManifest{makeManifest(docToTestMatchJsonSchema)},
+		Negative: true,
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"DocumentIndex:	0",
+		"ValuesIndex:	0",
+		"Path:	metadata.name",
+		"Expected NOT to match JSON schema:",
+		"	type: string",
+		"Actual:",
+		"	no violations",
+	}, diff)
+}
+
+func TestMatchJsonSchemaValidatorWhenUnknownPath(t *testing.T) {
+	validator := MatchJsonSchemaValidator{
+		Path:   "spec.unknown",
+		Schema: map[string]any{"type": "string"},
+	}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestMatchJsonSchema)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"DocumentIndex:	0",
+		"Error:",
+		"	unknown path spec.unknown",
+	}, diff)
+}
+
+func TestMatchJsonSchemaValidatorWhenSchemaAndSchemaFile(t *testing.T) {
+	validator := MatchJsonSchemaValidator{
+		Path:       "metadata.name",
+		Schema:     map[string]any{"type": "string"},
+		SchemaFile: "schema.json",
+	}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestMatchJsonSchema)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Error:",
+		"	expected exactly one of field 'schema' or 'schemaFile' to be filled",
+	}, diff)
+}
+
+func TestMatchJsonSchemaValidatorWhenSchemaFileNotFound(t *testing.T) {
+	validator := MatchJsonSchemaValidator{
+		Path:       "metadata.name",
+		SchemaFile: "notfound.json",
+	}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:    []common.K8sManifest{makeManifest(docToTestMatchJsonSchema)},
+		BaseDir: t.TempDir(),
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, "Error:", diff[0])
+	assert.Contains(t, diff[1], "notfound.json")
+}
+
+func TestMatchJsonSchemaValidatorWhenSchemaInvalid(t *testing.T) {
+	validator := MatchJsonSchemaValidator{
+		Path:   "metadata.name",
+		Schema: map[string]any{"type": "unknown"},
+	}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestMatchJsonSchema)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, "Error:", diff[0])
+}
+
+func TestMatchJsonSchemaValidatorWhenPathEmpty(t *testing.T) {
+	validator := MatchJsonSchemaValidator{Schema: map[string]any{"type": "string"}}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestMatchJsonSchema)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Error:",
+		"	expected field 'path' to be filled",
+	}, diff)
+}
+
+func TestMatchJsonSchemaValidatorWhenNoManifestFail(t *testing.T) {
+	validator := MatchJsonSchemaValidator{
+		Path:   "metadata.name",
+		Schema: map[string]any{"type": "string"},
+	}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Path:	metadata.name",
+		"Expected to match JSON schema:",
+		"	type: string",
+		"Actual:",
+		"	no manifest found",
+	}, diff)
+}
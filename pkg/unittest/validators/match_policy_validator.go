@@ -0,0 +1,228 @@
+package validators
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+
+	"github.com/open-policy-agent/opa/v1/loader"
+	"github.com/open-policy-agent/opa/v1/rego"
+	"github.com/open-policy-agent/opa/v1/storage/inmem"
+	log "github.com/sirupsen/logrus"
+)
+
+// defaultPolicyNamespace the package of the policies which is evaluated, the same default as conftest.
+const defaultPolicyNamespace = "main"
+
+// policyRules the rules, and the prefixes of rules (like `deny_privileged`), reporting violations.
+var policyRules = []string{"deny", "violation"}
+
+// MatchPolicyValidator validate whether every document passes the Rego policies of the Policy file or directory.
+// Each document is the `input` of the policies, every message of the `deny` and `violation` rules
+// in the Namespace (default `main`) is reported as a violation.
+type MatchPolicyValidator struct {
+	Policy    string
+	Namespace string
+}
+
+// MatchReleasePolicyValidator validate whether the release passes the Rego policies of the Policy file or directory.
+// All documents of the release are the `input` of the policies, as a list.
+type MatchReleasePolicyValidator MatchPolicyValidator
+
+func (v MatchPolicyValidator) failInfo(violations []string, manifestIndex int, not bool) []string {
+	log.WithField("validator", "match_policy").Debugln("expected policy:", v.Policy)
+	log.WithField("validator", "match_policy").Debugln("actual violations:", violations)
+
+	actual := "no violations"
+	if len(violations) > 0 {
+		actual = strings.Join(violations, "\n")
+	}
+
+	return splitInfof(
+		setFailFormat(not, false, true, false, " to match policy"),
+		manifestIndex,
+		-1,
+		v.Policy,
+		actual,
+	)
+}
+
+// prepare loads the policies of the file or directory, of the fsys when it is set,
+// and prepares the query of the namespace.
+func (v MatchPolicyValidator) prepare(fsys fs.FS, policy string) (rego.PreparedEvalQuery, error) {
+	namespace := v.Namespace
+	if namespace == "" {
+		namespace = defaultPolicyNamespace
+	}
+
+	options := []func(*rego.Rego){rego.Query("data." + namespace)}
+	if fsys == nil {
+		options = append(options, rego.Load([]string{policy}, nil))
+	} else {
+		// rego.Load only reads from the OS file system, so the policies and data are loaded like it does.
+		loaded, err := loader.NewFileLoader().WithFS(fsys).WithProcessAnnotation(true).All([]string{policy})
+		if err != nil {
+			return rego.PreparedEvalQuery{}, err
+		}
+		for _, module := range loaded.ParsedModules() {
+			options = append(options, rego.ParsedModule(module))
+		}
+		options = append(options, rego.Store(inmem.NewFromObject(loaded.Documents)))
+	}
+
+	return rego.New(options...).PrepareForEval(context.Background())
+}
+
+// evaluate evaluates the policies with the input and returns the violation messages.
+func (v MatchPolicyValidator) evaluate(query rego.PreparedEvalQuery, input any) ([]string, error) {
+	resultSet, err := query.Eval(context.Background(), rego.EvalInput(input))
+	if err != nil {
+		return nil, err
+	}
+
+	violations := make([]string, 0)
+	for _, result := range resultSet {
+		for _, expression := range result.Expressions {
+			rules, ok := expression.Value.(map[string]any)
+			if !ok {
+				continue
+			}
+			for name, value := range rules {
+				if isPolicyRule(name) {
+					violations = append(violations, policyMessages(value)...)
+				}
+			}
+		}
+	}
+	sort.Strings(violations)
+
+	return violations, nil
+}
+
+// validate evaluates the policies for every input, identified by its manifest index
+// unless the input is the whole release.
+func (v MatchPolicyValidator) validate(context *ValidateContext, inputs []any, release bool) (bool, []string) {
+	verr := validateRequiredField(v.Policy, "policy")
+	if verr != nil {
+		return false, splitInfof(errorFormat, -1, -1, verr.Error())
+	}
+
+	query, err := v.prepare(context.FileSystem, context.resolveFile(v.Policy))
+	if err != nil {
+		return false, splitInfof(errorFormat, -1, -1, err.Error())
+	}
+
+	validateSuccess := false
+	validateErrors := make([]string, 0)
+
+	for manifestIndex, input := range inputs {
+		if release {
+			manifestIndex = -1
+		}
+
+		violations, err := v.evaluate(query, input)
+		if err != nil {
+			validateSuccess = false
+			errorMessage := splitInfof(errorFormat, manifestIndex, -1, err.Error())
+			validateErrors = append(validateErrors, errorMessage...)
+			if context.FailFast {
+				break
+			}
+			continue
+		}
+
+		if (len(violations) == 0) == context.Negative {
+			validateSuccess = false
+			errorMessage := v.failInfo(violations, manifestIndex, context.Negative)
+			validateErrors = append(validateErrors, errorMessage...)
+			if context.FailFast {
+				break
+			}
+			continue
+		}
+
+		validateSuccess = determineSuccess(max(manifestIndex, 0), validateSuccess, true)
+	}
+
+	if len(inputs) == 0 && !context.Negative {
+		errorMessage := splitInfof(setFailFormat(false, false, true, false, " to match policy"), -1, -1, v.Policy, "no manifest found")
+		validateErrors = append(validateErrors, errorMessage...)
+	} else if len(inputs) == 0 && context.Negative {
+		validateSuccess = true
+	}
+
+	return validateSuccess, validateErrors
+}
+
+// Validate implement Validatable
+func (v MatchPolicyValidator) Validate(context *ValidateContext) (bool, []string) {
+	manifests := context.getManifests()
+
+	inputs := make([]any, 0, len(manifests))
+	for _, manifest := range manifests {
+		inputs = append(inputs, manifest)
+	}
+
+	return v.validate(context, inputs, false)
+}
+
+// Validate implement Validatable
+func (v MatchReleasePolicyValidator) Validate(context *ValidateContext) (bool, []string) {
+	manifests := context.getManifests()
+
+	documents := make([]any, 0, len(manifests))
+	for _, manifest := range manifests {
+		documents = append(documents, manifest)
+	}
+
+	inputs := make([]any, 0, 1)
+	if len(documents) > 0 {
+		inputs = append(inputs, documents)
+	}
+
+	return MatchPolicyValidator(v).validate(context, inputs, true)
+}
+
+// isPolicyRule checks if the rule reports violations.
+func isPolicyRule(name string) bool {
+	for _, rule := range policyRules {
+		if name == rule || strings.HasPrefix(name, rule+"_") {
+			return true
+		}
+	}
+	return false
+}
+
+// policyMessages returns the messages of a rule, which are either strings or objects with a `msg`.
+func policyMessages(value any) []string {
+	var items []any
+	switch typed := value.(type) {
+	case []any:
+		items = typed
+	case bool:
+		if typed {
+			items = []any{"policy violated"}
+		}
+	default:
+		items = []any{typed}
+	}
+
+	messages := make([]string, 0, len(items))
+	for _, item := range items {
+		if object, ok := item.(map[string]any); ok {
+			if msg, ok := object["msg"]; ok {
+				messages = append(messages, fmt.Sprint(msg))
+				continue
+			}
+			if data, err := json.Marshal(object); err == nil {
+				messages = append(messages, string(data))
+				continue
+			}
+		}
+		messages = append(messages, fmt.Sprint(item))
+	}
+	return messages
+}
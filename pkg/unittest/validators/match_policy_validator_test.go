@@ -0,0 +1,244 @@
+package validators_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+	. "github.com/helm-unittest/helm-unittest/pkg/unittest/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+var docToTestMatchPolicy = `
+kind: Deployment
+metadata:
+  name: my-app
+spec:
+  template:
+    spec:
+      containers:
+        - name: app
+          image: nginx:latest
+          securityContext:
+            privileged: true
+`
+
+var docToTestMatchPolicyService = `
+kind: Service
+metadata:
+  name: my-app
+  labels:
+    team: platform
+`
+
+var policyToTestMatchPolicy = `
+package main
+
+import rego.v1
+
+deny contains msg if {
+	some container in input.spec.template.spec.containers
+	container.securityContext.privileged
+	msg := sprintf("container '%s' must not be privileged", [container.name])
+}
+
+violation_latest contains {"msg": msg} if {
+	some container in input.spec.template.spec.containers
+	endswith(container.image, ":latest")
+	msg := sprintf("container '%s' must not use the latest tag", [container.name])
+}
+
+warn contains "warnings are not violations" if {
+	input.kind == "Service"
+}
+`
+
+var policyToTestMatchReleasePolicy = `
+package release
+
+import rego.v1
+
+deny contains msg if {
+	some doc in input
+	not doc.metadata.labels.team
+	msg := sprintf("%s '%s' must have a team label", [doc.kind, doc.metadata.name])
+}
+`
+
+// writePolicies writes the policies to a temporary directory and returns the directory.
+func writePolicies(t *testing.T, policies map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, policy := range policies {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(policy), 0644))
+	}
+	return dir
+}
+
+func TestMatchPolicyValidatorWhenOk(t *testing.T) {
+	dir := writePolicies(t, map[string]string{"policy.rego": policyToTestMatchPolicy})
+
+	validator := MatchPolicyValidator{Policy: "policy.rego"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:    []common.K8sManifest{makeManifest(docToTestMatchPolicyService)},
+		BaseDir: dir,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestMatchPolicyValidatorWhenFail(t *testing.T) {
+	dir := writePolicies(t, map[string]string{"policy.rego": policyToTestMatchPolicy})
+
+	validator := MatchPolicyValidator{Policy: dir}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestMatchPolicyService), makeManifest(docToTestMatchPolicy)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"DocumentIndex:	1",
+		"Expected to match policy:",
+		"	" + dir,
+		"Actual:",
+		"	container 'app' must not be privileged",
+		"	container 'app' must not use the latest tag",
+	}, diff)
+}
+
+func TestMatchPolicyValidatorWhenNamespaceOk(t *testing.T) {
+	dir := writePolicies(t, map[string]string{"policy.rego": policyToTestMatchPolicy, "release.rego": policyToTestMatchReleasePolicy})
+
+	validator := MatchPolicyValidator{Policy: ".", Namespace: "main"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:    []common.K8sManifest{makeManifest(docToTestMatchPolicyService)},
+		BaseDir: dir,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestMatchPolicyValidatorWhenNegativeAndOk(t *testing.T) {
+	dir := writePolicies(t, map[string]string{"policy.rego": policyToTestMatchPolicy})
+
+	validator := MatchPolicyValidator{Policy: "policy.rego"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:     []common.K8sManifest{makeManifest(docToTestMatchPolicy)},
+		Negative: true,
+		BaseDir:  dir,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestMatchPolicyValidatorWhenNegativeAndFail(t *testing.T) {
+	dir := writePolicies(t, map[string]string{"policy.rego": policyToTestMatchPolicy})
+
+	validator := MatchPolicyValidator{Policy: "policy.rego"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:     []common.K8sManifest{makeManifest(docToTestMatchPolicyService)},
+		Negative: true,
+		BaseDir:  dir,
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"DocumentIndex:	0",
+		"Expected NOT to match policy:",
+		"	policy.rego",
+		"Actual:",
+		"	no violations",
+	}, diff)
+}
+
+func TestMatchPolicyValidatorWhenPolicyInvalid(t *testing.T) {
+	dir := writePolicies(t, map[string]string{"policy.rego": "package main\n\ndeny contains msg"})
+
+	validator := MatchPolicyValidator{Policy: "policy.rego"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:    []common.K8sManifest{makeManifest(docToTestMatchPolicy)},
+		BaseDir: dir,
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, "Error:", diff[0])
+	assert.Contains(t, diff[1], "policy.rego")
+}
+
+func TestMatchPolicyValidatorWhenPolicyNotFound(t *testing.T) {
+	validator := MatchPolicyValidator{Policy: "notfound.rego"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:    []common.K8sManifest{makeManifest(docToTestMatchPolicy)},
+		BaseDir: t.TempDir(),
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, "Error:", diff[0])
+	assert.Contains(t, diff[1], "notfound.rego")
+}
+
+func TestMatchPolicyValidatorWhenPolicyEmpty(t *testing.T) {
+	validator := MatchPolicyValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestMatchPolicy)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Error:",
+		"	expected field 'policy' to be filled",
+	}, diff)
+}
+
+func TestMatchPolicyValidatorWhenNoManifestFail(t *testing.T) {
+	dir := writePolicies(t, map[string]string{"policy.rego": policyToTestMatchPolicy})
+
+	validator := MatchPolicyValidator{Policy: "policy.rego"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:    []common.K8sManifest{},
+		BaseDir: dir,
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Expected to match policy:",
+		"	policy.rego",
+		"Actual:",
+		"	no manifest found",
+	}, diff)
+}
+
+func TestMatchReleasePolicyValidatorWhenOk(t *testing.T) {
+	dir := writePolicies(t, map[string]string{"release.rego": policyToTestMatchReleasePolicy})
+
+	validator := MatchReleasePolicyValidator{Policy: "release.rego", Namespace: "release"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:    []common.K8sManifest{makeManifest(docToTestMatchPolicyService)},
+		BaseDir: dir,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestMatchReleasePolicyValidatorWhenFail(t *testing.T) {
+	dir := writePolicies(t, map[string]string{"release.rego": policyToTestMatchReleasePolicy})
+
+	validator := MatchReleasePolicyValidator{Policy: "release.rego", Namespace: "release"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:    []common.K8sManifest{makeManifest(docToTestMatchPolicyService), makeManifest(docToTestMatchPolicy)},
+		BaseDir: dir,
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Expected to match policy:",
+		"	release.rego",
+		"Actual:",
+		"	Deployment 'my-app' must have a team label",
+	}, diff)
+}
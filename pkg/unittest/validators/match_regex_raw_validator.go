@@ -1,24 +1,31 @@
 package validators
 
 import (
+	"fmt"
 	"regexp"
 
 	"github.com/helm-unittest/helm-unittest/internal/common"
 	log "github.com/sirupsen/logrus"
 )
 
-// MatchRegexRawValidator validate value of Path match Pattern
+// MatchRegexRawValidator validate value of Path match Pattern.
+// When Count is given, the Pattern must match exactly Count times.
 type MatchRegexRawValidator struct {
 	Pattern string
+	Count   *int
 }
 
-func (v MatchRegexRawValidator) failInfo(actual string, not bool) []string {
+func (v MatchRegexRawValidator) failInfo(actual string, matches int, not bool) []string {
+	customMessage := " to match"
+	if v.Count != nil {
+		customMessage = fmt.Sprintf(" to match %d times, found %d matches", *v.Count, matches)
+	}
 
 	log.WithField("validator", "match_regex_raw").Debugln("expected pattern:", v.Pattern)
 	log.WithField("validator", "match_regex_raw").Debugln("actual content:", actual)
 
 	return splitInfof(
-		setFailFormat(not, false, true, false, " to match"),
+		setFailFormat(not, false, true, false, customMessage),
 		-1,
 		-1,
 		v.Pattern,
@@ -46,9 +53,15 @@ func (v MatchRegexRawValidator) Validate(context *ValidateContext) (bool, []stri
 			return false, splitInfof(errorFormat, -1, -1, err.Error())
 		}
 
-		if p.MatchString(actual) == context.Negative {
+		matches := len(p.FindAllStringIndex(actual, -1))
+		matched := matches > 0
+		if v.Count != nil {
+			matched = matches == *v.Count
+		}
+
+		if matched == context.Negative {
 			validateSuccess = false
-			errorMessage := v.failInfo(actual, context.Negative)
+			errorMessage := v.failInfo(actual, matches, context.Negative)
 			validateErrors = append(validateErrors, errorMessage...)
 
 			if context.FailFast {
@@ -61,7 +74,7 @@ func (v MatchRegexRawValidator) Validate(context *ValidateContext) (bool, []stri
 	}
 
 	if len(manifests) == 0 && !context.Negative {
-		errorMessage := v.failInfo("no manifest found", context.Negative)
+		errorMessage := v.failInfo("no manifest found", 0, context.Negative)
 		validateErrors = append(validateErrors, errorMessage...)
 	} else if len(manifests) == 0 && context.Negative {
 		validateSuccess = true
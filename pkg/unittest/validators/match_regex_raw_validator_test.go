@@ -17,7 +17,7 @@ raw: |
 func TestMatchRegexRawValidatorWhenOk(t *testing.T) {
 	manifest := makeManifest(docToTestMatchRegexRaw)
 
-	validator := MatchRegexRawValidator{"^This"}
+	validator := MatchRegexRawValidator{Pattern: "^This"}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs: []common.K8sManifest{manifest},
 	})
@@ -29,7 +29,7 @@ func TestMatchRegexRawValidatorWhenOk(t *testing.T) {
 func TestMatchRegexRawValidatorWhenNegativeAndOk(t *testing.T) {
 	manifest := makeManifest(docToTestMatchRegexRaw)
 
-	validator := MatchRegexRawValidator{"^foo"}
+	validator := MatchRegexRawValidator{Pattern: "^foo"}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs:     []common.K8sManifest{manifest},
 		Negative: true,
@@ -41,7 +41,7 @@ func TestMatchRegexRawValidatorWhenNegativeAndOk(t *testing.T) {
 func TestMatchRegexRawValidatorWhenRegexCompileFail(t *testing.T) {
 	manifest := common.K8sManifest{"raw": ""}
 
-	validator := MatchRegexRawValidator{"+"}
+	validator := MatchRegexRawValidator{Pattern: "+"}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs: []common.K8sManifest{manifest},
 	})
@@ -57,7 +57,7 @@ func TestMatchRegexRawValidatorWhenMatchFail(t *testing.T) {
 
 	log.SetLevel(log.DebugLevel)
 
-	validator := MatchRegexRawValidator{"^foo"}
+	validator := MatchRegexRawValidator{Pattern: "^foo"}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs:     []common.K8sManifest{manifest},
 		FailFast: true,
@@ -74,7 +74,7 @@ func TestMatchRegexRawValidatorWhenMatchFail(t *testing.T) {
 func TestMatchRegexRawValidatorWhenNegativeAndMatchFail(t *testing.T) {
 	manifest := makeManifest(docToTestMatchRegexRaw)
 
-	validator := MatchRegexRawValidator{"^This"}
+	validator := MatchRegexRawValidator{Pattern: "^This"}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs:     []common.K8sManifest{manifest},
 		Negative: true,
@@ -92,7 +92,7 @@ func TestMatchRegexRawValidatorWhenNegativeAndMatchFail(t *testing.T) {
 func TestMatchRegexRawValidatorWhenNoPattern(t *testing.T) {
 	manifest := makeManifest(docToTestMatchRegex)
 
-	validator := MatchRegexRawValidator{""}
+	validator := MatchRegexRawValidator{Pattern: ""}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs: []common.K8sManifest{manifest},
 	})
@@ -105,7 +105,7 @@ func TestMatchRegexRawValidatorWhenNoPattern(t *testing.T) {
 }
 
 func TestMatchRegexRawValidatorWhenNoManifestFail(t *testing.T) {
-	validator := MatchRegexRawValidator{"^foo"}
+	validator := MatchRegexRawValidator{Pattern: "^foo"}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs: []common.K8sManifest{},
 	})
@@ -119,7 +119,7 @@ func TestMatchRegexRawValidatorWhenNoManifestFail(t *testing.T) {
 }
 
 func TestMatchRegexRawValidatorWhenNoManifestNegativeOk(t *testing.T) {
-	validator := MatchRegexRawValidator{"^foo"}
+	validator := MatchRegexRawValidator{Pattern: "^foo"}
 	pass, diff := validator.Validate(&ValidateContext{
 		Docs:     []common.K8sManifest{},
 		Negative: true,
@@ -127,3 +127,57 @@ func TestMatchRegexRawValidatorWhenNoManifestNegativeOk(t *testing.T) {
 	assert.True(t, pass)
 	assert.Equal(t, []string{}, diff)
 }
+
+var docToTestMatchRegexRawMultiLine = `
+raw: |
+  1. Get the application URL by running these commands:
+  export POD_NAME=$(kubectl get pods)
+  export CONTAINER_PORT=$(kubectl get pod $POD_NAME)
+`
+
+func TestMatchRegexRawValidatorWhenCountOk(t *testing.T) {
+	manifest := makeManifest(docToTestMatchRegexRawMultiLine)
+
+	count := 2
+	validator := MatchRegexRawValidator{Pattern: "(?m)^export ", Count: &count}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{manifest},
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestMatchRegexRawValidatorWhenCountFail(t *testing.T) {
+	manifest := makeManifest(docToTestMatchRegexRawMultiLine)
+
+	count := 1
+	validator := MatchRegexRawValidator{Pattern: "(?m)^export ", Count: &count}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{manifest},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Expected to match 1 times, found 2 matches:",
+		"	(?m)^export",
+		"Actual:",
+		"	1. Get the application URL by running these commands:",
+		"	export POD_NAME=$(kubectl get pods)",
+		"	export CONTAINER_PORT=$(kubectl get pod $POD_NAME)",
+	}, diff)
+}
+
+func TestMatchRegexRawValidatorWhenNegativeCountOk(t *testing.T) {
+	manifest := makeManifest(docToTestMatchRegexRawMultiLine)
+
+	count := 1
+	validator := MatchRegexRawValidator{Pattern: "(?m)^export ", Count: &count}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:     []common.K8sManifest{manifest},
+		Negative: true,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
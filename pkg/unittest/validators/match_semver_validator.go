@@ -0,0 +1,137 @@
+package validators
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/helm-unittest/helm-unittest/internal/common"
+	"github.com/helm-unittest/helm-unittest/pkg/unittest/valueutils"
+	log "github.com/sirupsen/logrus"
+)
+
+// MatchSemverValidator validate whether the version at Path matches the semantic version Constraint.
+// The version can be extracted from the value, like an image tag, with the Pattern.
+// The first capture group of the Pattern is used as version, or the whole match without capture groups.
+type MatchSemverValidator struct {
+	Path       string
+	Constraint string
+	Pattern    string
+}
+
+func (v MatchSemverValidator) failInfo(actual string, manifestIndex, actualIndex int, not bool) []string {
+	log.WithField("validator", "match_semver").Debugln("expected constraint:", v.Constraint)
+	log.WithField("validator", "match_semver").Debugln("actual version:", actual)
+
+	return splitInfof(
+		setFailFormat(not, true, true, false, " to match semver constraint"),
+		manifestIndex,
+		actualIndex,
+		v.Path,
+		v.Constraint,
+		actual,
+	)
+}
+
+// version extracts the version from the value, using the pattern when defined.
+func (v MatchSemverValidator) version(actual any, pattern *regexp.Regexp) (*semver.Version, error) {
+	value, ok := actual.(string)
+	if !ok {
+		value = fmt.Sprint(actual)
+	}
+
+	if pattern != nil {
+		matches := pattern.FindStringSubmatch(value)
+		if matches == nil {
+			return nil, fmt.Errorf("pattern '%s' does not match '%s'", v.Pattern, value)
+		}
+		value = matches[min(1, len(matches)-1)]
+	}
+
+	version, err := semver.NewVersion(value)
+	if err != nil {
+		return nil, fmt.Errorf("'%s' is not a valid semantic version", value)
+	}
+	return version, nil
+}
+
+func (v MatchSemverValidator) validateManifest(manifest common.K8sManifest, constraint *semver.Constraints, pattern *regexp.Regexp, manifestIndex int, context *ValidateContext) (bool, []string) {
+	actuals, err := valueutils.GetValueOfSetPath(manifest, v.Path)
+	if err != nil {
+		return false, splitInfof(errorFormat, manifestIndex, -1, err.Error())
+	}
+
+	if len(actuals) == 0 && !context.Negative {
+		return false, splitInfof(errorFormat, manifestIndex, -1, fmt.Sprintf("unknown path %s", v.Path))
+	}
+
+	validateManifestSuccess := (len(actuals) == 0 && context.Negative)
+	var validateManifestErrors []string
+
+	for actualIndex, actual := range actuals {
+		validateSingleSuccess := false
+
+		version, err := v.version(actual, pattern)
+		if err != nil {
+			validateManifestErrors = append(validateManifestErrors, splitInfof(errorFormat, manifestIndex, actualIndex, err.Error())...)
+		} else if constraint.Check(version) == context.Negative {
+			validateManifestErrors = append(validateManifestErrors, v.failInfo(version.Original(), manifestIndex, actualIndex, context.Negative)...)
+		} else {
+			validateSingleSuccess = true
+		}
+
+		validateManifestSuccess = determineSuccess(actualIndex, validateManifestSuccess, validateSingleSuccess)
+
+		if !validateManifestSuccess && context.FailFast {
+			break
+		}
+	}
+
+	return validateManifestSuccess, validateManifestErrors
+}
+
+// Validate implement Validatable
+func (v MatchSemverValidator) Validate(context *ValidateContext) (bool, []string) {
+	verr := validateRequiredField(v.Constraint, "constraint")
+	if verr != nil {
+		return false, splitInfof(errorFormat, -1, -1, verr.Error())
+	}
+
+	constraint, err := semver.NewConstraint(v.Constraint)
+	if err != nil {
+		return false, splitInfof(errorFormat, -1, -1, err.Error())
+	}
+
+	var pattern *regexp.Regexp
+	if v.Pattern != "" {
+		pattern, err = regexp.Compile(v.Pattern)
+		if err != nil {
+			return false, splitInfof(errorFormat, -1, -1, err.Error())
+		}
+	}
+
+	manifests := context.getManifests()
+
+	validateSuccess := false
+	validateErrors := make([]string, 0)
+
+	for manifestIndex, manifest := range manifests {
+		currentSuccess, validateSingleErrors := v.validateManifest(manifest, constraint, pattern, manifestIndex, context)
+
+		validateErrors = append(validateErrors, validateSingleErrors...)
+		validateSuccess = determineSuccess(manifestIndex, validateSuccess, currentSuccess)
+
+		if !validateSuccess && context.FailFast {
+			break
+		}
+	}
+
+	if len(manifests) == 0 && !context.Negative {
+		errorMessage := v.failInfo("no manifest found", -1, -1, context.Negative)
+		validateErrors = append(validateErrors, errorMessage...)
+	} else if len(manifests) == 0 && context.Negative {
+		validateSuccess = true
+	}
+
+	return validateSuccess, validateErrors
+}
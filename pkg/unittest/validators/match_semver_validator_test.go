@@ -0,0 +1,203 @@
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+	. "github.com/helm-unittest/helm-unittest/pkg/unittest/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+var docToTestMatchSemver = `
+metadata:
+  labels:
+    app.kubernetes.io/version: v1.4.2
+spec:
+  initContainers:
+    - name: init
+      image: busybox:1.36.1
+  containers:
+    - name: app
+      image: registry.example.com/app:2.1.0-alpine
+    - name: sidecar
+      image: envoyproxy/envoy:v1.29.3
+`
+
+func TestMatchSemverValidatorWhenOk(t *testing.T) {
+	tests := []struct {
+		name, path, constraint, pattern string
+	}{
+		{name: "label", path: "metadata.labels[\"app.kubernetes.io/version\"]", constraint: ">=1.2.0 <2.0.0"},
+		{name: "image tag", path: "spec.containers[?(@.name == 'sidecar')].image", constraint: "~1.29", pattern: ":(.+)$"},
+		{name: "whole match", path: "spec.initContainers[0].image", constraint: "^1.36", pattern: `\d+\.\d+\.\d+`},
+		{name: "all containers", path: "spec.containers[*].image", constraint: ">=1.0.0-0", pattern: `:v?(\d+\.\d+\.\d+)`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := MatchSemverValidator{
+				Path:       tt.path,
+				Constraint: tt.constraint,
+				Pattern:    tt.pattern,
+			}
+			pass, diff := validator.Validate(&ValidateContext{
+				Docs: []common.K8sManifest{makeManifest(docToTestMatchSemver)},
+			})
+
+			assert.True(t, pass)
+			assert.Equal(t, []string{}, diff)
+		})
+	}
+}
+
+func TestMatchSemverValidatorWhenFail(t *testing.T) {
+	validator := MatchSemverValidator{
+		Path:       "spec.containers[*].image",
+		Constraint: ">=1.0.0 <2.0.0",
+		Pattern:    `:v?(\d+\.\d+\.\d+)`,
+	}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestMatchSemver)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"DocumentIndex:	0",
+		"ValuesIndex:	0",
+		"Path:	spec.containers[*].image",
+		"Expected to match semver constraint:",
+		"	>=1.0.0 <2.0.0",
+		"Actual:",
+		"	2.1.0",
+	}, diff)
+}
+
+func TestMatchSemverValidatorWhenNegativeAndOk(t *testing.T) {
+	validator := MatchSemverValidator{
+		Path:       "spec.initContainers[0].image",
+		Constraint: "<1.36.0",
+		Pattern:    ":(.+)$",
+	}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:     []common.K8sManifest{makeManifest(docToTestMatchSemver)},
+		Negative: true,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestMatchSemverValidatorWhenNegativeAndFail(t *testing.T) {
+	validator := MatchSemverValidator{
+		Path:       "metadata.labels[\"app.kubernetes.io/version\"]",
+		Constraint: "1.x",
+	}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:     []common.K8sManifest{makeManifest(docToTestMatchSemver)},
+		Negative: true,
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"DocumentIndex:	0",
+		"ValuesIndex:	0",
+		"Path:	metadata.labels[\"app.kubernetes.io/version\"]",
+		"Expected NOT to match semver constraint:",
+		"	1.x",
+		"Actual:",
+		"	v1.4.2",
+	}, diff)
+}
+
+func TestMatchSemverValidatorWhenPatternDoesNotMatch(t *testing.T) {
+	validator := MatchSemverValidator{
+		Path:       "spec.initContainers[0].image",
+		Constraint: ">=1.0.0",
+		Pattern:    "@sha256:(.+)$",
+	}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestMatchSemver)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"DocumentIndex:	0",
+		"ValuesIndex:	0",
+		"Error:",
+		"	pattern '@sha256:(.+)$' does not match 'busybox:1.36.1'",
+	}, diff)
+}
+
+func TestMatchSemverValidatorWhenInvalidVersion(t *testing.T) {
+	validator := MatchSemverValidator{
+		Path:       "spec.initContainers[0].name",
+		Constraint: ">=1.0.0",
+	}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestMatchSemver)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"DocumentIndex:	0",
+		"ValuesIndex:	0",
+		"Error:",
+		"	'init' is not a valid semantic version",
+	}, diff)
+}
+
+func TestMatchSemverValidatorWhenInvalidConstraint(t *testing.T) {
+	validator := MatchSemverValidator{
+		Path:       "spec.initContainers[0].image",
+		Constraint: ">=a.b",
+	}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestMatchSemver)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, "Error:", diff[0])
+}
+
+func TestMatchSemverValidatorWhenConstraintEmpty(t *testing.T) {
+	validator := MatchSemverValidator{Path: "spec.initContainers[0].image"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestMatchSemver)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Error:",
+		"	expected field 'constraint' to be filled",
+	}, diff)
+}
+
+func TestMatchSemverValidatorWhenUnknownPath(t *testing.T) {
+	validator := MatchSemverValidator{Path: "spec.unknown", Constraint: ">=1.0.0"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestMatchSemver)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"DocumentIndex:	0",
+		"Error:",
+		"	unknown path spec.unknown",
+	}, diff)
+}
+
+func TestMatchSemverValidatorWhenNoManifestFail(t *testing.T) {
+	validator := MatchSemverValidator{Path: "metadata.labels.version", Constraint: ">=1.0.0"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Path:	metadata.labels.version",
+		"Expected to match semver constraint:",
+		"	>=1.0.0",
+		"Actual:",
+		"	no manifest found",
+	}, diff)
+}
@@ -1,24 +1,41 @@
 package validators
 
 import (
+	"cmp"
 	"fmt"
 	"reflect"
+	"strconv"
+	"time"
 
 	"github.com/helm-unittest/helm-unittest/internal/common"
 	"github.com/helm-unittest/helm-unittest/pkg/unittest/valueutils"
 	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	// compareAsQuantity compares the values as Kubernetes quantities, like 500m or 1Gi.
+	compareAsQuantity = "quantity"
+	// compareAsDuration compares the values as durations, like 90s or 1h30m.
+	compareAsDuration = "duration"
 )
 
 // operatorValidator validate whether the value of Path is according to the behaviour of operatorgreater to Value
 // internal struct, not exposed to end user
 type operatorValidator struct {
-	Path           string
-	Value          any
+	Path  string
+	Value any
+	// Max the upper bound of the between comparison, the Value is the lower bound.
+	Max            any
 	ComparisonType string
+	CompareAs      string
 }
 
 func (o operatorValidator) failInfo(msg, comparisonType string, manifestIndex, actualIndex int, not bool) []string {
 	customMsg := fmt.Sprintf(" to be %s then or equal to, got", comparisonType)
+	if comparisonType == "between" {
+		customMsg = " to be between, got"
+	}
 	return splitInfof(
 		setFailFormat(not, true, false, false, customMsg),
 		manifestIndex,
@@ -28,53 +45,90 @@ func (o operatorValidator) failInfo(msg, comparisonType string, manifestIndex, a
 	)
 }
 
-// compareValues performs a validation of a Kubernetes manifest against an expected value.
-// It compares the actual value retrieved from the manifest with the expected value,
-// ensuring that they are of compatible types and that the actual value is greater, less than or equal to the expected value.
-// If successful, it returns true along with a nil error slice. If unsuccessful, it returns false
-// along with an error slice containing information about the validation failure.
-func (o operatorValidator) compareValues(expected, actual any, comparisonType string, negative bool) (bool, []string) {
-	expStr := fmt.Sprintf("%v", expected)
-	actStr := fmt.Sprintf("%v", actual)
-	result := false
+// compareValues compares the actual value with the expected value, using the CompareAs mode.
+// It returns -1, 0 or +1 when the actual value is less than, equal to or greater than the expected value,
+// or an error when the values can not be compared.
+func (o operatorValidator) compareValues(expected, actual any) (int, error) {
+	switch o.CompareAs {
+	case "":
+		return o.compareTypedValues(expected, actual)
+	case compareAsQuantity:
+		expectedQuantity, err := toQuantity(expected)
+		if err != nil {
+			return 0, err
+		}
+		actualQuantity, err := toQuantity(actual)
+		if err != nil {
+			return 0, err
+		}
+		return actualQuantity.Cmp(expectedQuantity), nil
+	case compareAsDuration:
+		expectedDuration, err := toDuration(expected)
+		if err != nil {
+			return 0, err
+		}
+		actualDuration, err := toDuration(actual)
+		if err != nil {
+			return 0, err
+		}
+		return cmp.Compare(actualDuration, expectedDuration), nil
+	default:
+		return 0, fmt.Errorf("unsupported compareAs '%s', expected one of %s or %s", o.CompareAs, compareAsQuantity, compareAsDuration)
+	}
+}
+
+// compareTypedValues compares values of the same type, strings are compared lexically.
+// Integers and floats are compared numerically.
+func (o operatorValidator) compareTypedValues(expected, actual any) (int, error) {
+	if actualNumber, ok := toFloat(actual); ok {
+		if expectedNumber, ok := toFloat(expected); ok {
+			return cmp.Compare(actualNumber, expectedNumber), nil
+		}
+	}
+
+	actType := reflect.TypeOf(actual)
+	expType := reflect.TypeOf(expected)
+	if actType != expType {
+		return 0, fmt.Errorf("actual '%s' and expected '%s' types do not match", actType, expType)
+	}
 
 	switch exp := expected.(type) {
 	case string:
-		result = o.compareStringValues(exp, actual.(string), comparisonType, negative)
+		return cmp.Compare(actual.(string), exp), nil
 	case int:
-		result = o.compareIntValues(exp, actual.(int), comparisonType, negative)
+		return cmp.Compare(actual.(int), exp), nil
 	case float64:
-		result = o.compareFloatValues(exp, actual.(float64), comparisonType, negative)
+		return cmp.Compare(actual.(float64), exp), nil
 	default:
-		return false, []string{fmt.Sprintf("unsupported type '%T'", expected)}
-	}
-
-	if !result {
-		return false, []string{fmt.Sprintf("the actual '%s' is not %s or equal to the expected '%s'", actStr, comparisonType, expStr)}
+		return 0, fmt.Errorf("unsupported type '%T'", expected)
 	}
-
-	return true, nil
 }
 
-func (o operatorValidator) compareStringValues(expected, actual string, comparisonType string, negative bool) bool {
-	if (comparisonType == "greater" && actual >= expected) || (comparisonType == "less" && actual <= expected) == negative {
-		return true
+// compare validates the actual value according to the ComparisonType.
+// It returns whether the comparison holds and a description of the comparison.
+func (o operatorValidator) compare(actual any) (bool, string, error) {
+	expected := o.Value
+	if o.ComparisonType == "between" {
+		lower, err := o.compareValues(o.Value, actual)
+		if err != nil {
+			return false, "", err
+		}
+		upper, err := o.compareValues(o.Max, actual)
+		if err != nil {
+			return false, "", err
+		}
+		return lower >= 0 && upper <= 0, fmt.Sprintf("between '%v' and '%v'", o.Value, o.Max), nil
 	}
-	return false
-}
 
-func (o operatorValidator) compareIntValues(expected, actual int, comparisonType string, negative bool) bool {
-	if (comparisonType == "greater" && actual >= expected) || (comparisonType == "less" && actual <= expected) == negative {
-		return true
+	result, err := o.compareValues(expected, actual)
+	if err != nil {
+		return false, "", err
 	}
-	return false
-}
-
-func (o operatorValidator) compareFloatValues(expected, actual float64, comparisonType string, negative bool) bool {
-	if (comparisonType == "greater" && actual >= expected) || (comparisonType == "less" && actual <= expected) == negative {
-		return true
+	description := fmt.Sprintf("%s or equal to the expected '%v'", o.ComparisonType, expected)
+	if o.ComparisonType == "greater" {
+		return result >= 0, description, nil
 	}
-	return false
+	return result <= 0, description, nil
 }
 
 func (o operatorValidator) validateManifest(manifest common.K8sManifest, manifestIndex int, context *ValidateContext) (bool, []string) {
@@ -91,25 +145,24 @@ func (o operatorValidator) validateManifest(manifest common.K8sManifest, manifes
 	var validateManifestErrors []string
 
 	for actualIndex, actual := range actuals {
-		actType := reflect.TypeOf(actual)
-		expType := reflect.TypeOf(o.Value)
-
 		validateSingleSuccess := false
-		var validateSingleErrors []string
 
-		if actType != expType {
-			errorMessage := splitInfof(errorFormat, manifestIndex, actualIndex, fmt.Sprintf("actual '%s' and expected '%s' types do not match", actType, expType))
+		holds, description, err := o.compare(actual)
+		if err != nil {
+			errorMessage := splitInfof(errorFormat, manifestIndex, actualIndex, err.Error())
 			validateManifestErrors = append(validateManifestErrors, errorMessage...)
-			continue
-		}
-
-		validateSingleSuccess, errors := o.compareValues(o.Value, actual, o.ComparisonType, !context.Negative)
-		if errors != nil {
-			errorMessage := o.failInfo(errors[0], o.ComparisonType, manifestIndex, actualIndex, context.Negative)
-			validateSingleErrors = append(validateSingleErrors, errorMessage...)
+		} else if holds == context.Negative {
+			notAnnotation := ""
+			if !holds {
+				notAnnotation = "not "
+			}
+			msg := fmt.Sprintf("the actual '%v' is %s%s", actual, notAnnotation, description)
+			errorMessage := o.failInfo(msg, o.ComparisonType, manifestIndex, actualIndex, context.Negative)
+			validateManifestErrors = append(validateManifestErrors, errorMessage...)
+		} else {
+			validateSingleSuccess = true
 		}
 
-		validateManifestErrors = append(validateManifestErrors, validateSingleErrors...)
 		validateManifestSuccess = determineSuccess(actualIndex, validateManifestSuccess, validateSingleSuccess)
 
 		if !validateManifestSuccess && context.FailFast {
@@ -147,3 +200,50 @@ func (o operatorValidator) Validate(context *ValidateContext) (bool, []string) {
 
 	return validateSuccess, validateErrors
 }
+
+// toFloat converts an int or float64 into a float64, to compare numbers of both types.
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+// toQuantity converts a string or number into a Kubernetes quantity.
+func toQuantity(value any) (resource.Quantity, error) {
+	var quantity string
+	switch v := value.(type) {
+	case string:
+		quantity = v
+	case int:
+		quantity = strconv.Itoa(v)
+	case float64:
+		quantity = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return resource.Quantity{}, fmt.Errorf("'%v' of type '%T' is not a valid quantity", value, value)
+	}
+
+	parsed, err := resource.ParseQuantity(quantity)
+	if err != nil {
+		return resource.Quantity{}, fmt.Errorf("'%v' is not a valid quantity", value)
+	}
+	return parsed, nil
+}
+
+// toDuration converts a string into a duration.
+func toDuration(value any) (time.Duration, error) {
+	duration, ok := value.(string)
+	if !ok {
+		return 0, fmt.Errorf("'%v' of type '%T' is not a valid duration", value, value)
+	}
+
+	parsed, err := time.ParseDuration(duration)
+	if err != nil {
+		return 0, fmt.Errorf("'%v' is not a valid duration", value)
+	}
+	return parsed, nil
+}
@@ -0,0 +1,226 @@
+package validators
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+	"github.com/helm-unittest/helm-unittest/pkg/unittest/valueutils"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// QuantifierEvery every element should pass the nested assertions.
+	QuantifierEvery = "every"
+	// QuantifierSome at least one element should pass the nested assertions.
+	QuantifierSome = "some"
+	// QuantifierNone no element should pass the nested assertions.
+	QuantifierNone = "none"
+)
+
+// QuantifiedAssertion a nested assertion of a quantifier, validated against a single element.
+type QuantifiedAssertion struct {
+	AssertType string
+	Validator  Validatable
+	Negative   bool
+}
+
+// QuantifierValidator validate the elements of the array at Path, or else the selected documents,
+// against the nested Asserts. An element passes when it passes all nested Asserts,
+// the Quantifier determines how many elements should pass.
+type QuantifierValidator struct {
+	Quantifier string
+	Path       string
+	Asserts    []QuantifiedAssertion
+}
+
+func (v QuantifierValidator) failInfo(actual string, manifestIndex, elementIndex int, not bool) []string {
+	expected := v.expected()
+
+	log.WithField("validator", v.Quantifier).Debugln("expected asserts:", expected)
+	log.WithField("validator", v.Quantifier).Debugln("actual:", actual)
+
+	customMessage := fmt.Sprintf(" %s element to pass", v.Quantifier)
+	if v.Path == "" {
+		customMessage = fmt.Sprintf(" %s document to pass", v.Quantifier)
+		return splitInfof(setFailFormat(not, false, true, false, customMessage), manifestIndex, elementIndex, expected, actual)
+	}
+	return splitInfof(setFailFormat(not, true, true, false, customMessage), manifestIndex, elementIndex, v.Path, expected, actual)
+}
+
+// expected describes the nested assertions.
+func (v QuantifierValidator) expected() string {
+	asserts := make([]string, 0, len(v.Asserts))
+	for _, assert := range v.Asserts {
+		if assert.Negative {
+			asserts = append(asserts, "not "+assert.AssertType)
+		} else {
+			asserts = append(asserts, assert.AssertType)
+		}
+	}
+	return strings.Join(asserts, "\n")
+}
+
+// validateElement validates the element against all nested assertions.
+// It returns whether the element passed and the failure information of the nested assertions.
+func (v QuantifierValidator) validateElement(element common.K8sManifest, context *ValidateContext) (bool, []string) {
+	elementPassed := true
+	elementErrors := make([]string, 0)
+
+	for _, assert := range v.Asserts {
+		passed, errors := assert.Validator.Validate(&ValidateContext{
+			Template:         context.Template,
+			Docs:             []common.K8sManifest{element},
+			Negative:         assert.Negative,
+			SnapshotComparer: context.SnapshotComparer,
+			RenderError:      context.RenderError,
+			ClusterObjects:   context.ClusterObjects,
+			RenderContext:    context.RenderContext,
+			BaseDir:          context.BaseDir,
+		})
+		if !passed {
+			elementPassed = false
+			// the element is the only document, so its index adds no information
+			for _, line := range errors {
+				if !strings.HasPrefix(line, "DocumentIndex:\t") {
+					elementErrors = append(elementErrors, line)
+				}
+			}
+		}
+	}
+
+	return elementPassed, elementErrors
+}
+
+// validateElements validates the elements according to the quantifier.
+// On failure the elements which made the quantifier fail are reported, or else a summary.
+// Like an unknown path, no elements fail unless negated, for every quantifier and for documents as well.
+func (v QuantifierValidator) validateElements(elements []common.K8sManifest, manifestIndex int, context *ValidateContext) (bool, []string) {
+	if len(elements) == 0 {
+		if context.Negative {
+			return true, []string{}
+		}
+		if v.Path == "" {
+			return false, v.failInfo("no manifest found", -1, -1, context.Negative)
+		}
+		return false, v.failInfo("no element found", manifestIndex, -1, context.Negative)
+	}
+
+	reportPassed := v.Quantifier == QuantifierNone && !context.Negative || v.Quantifier == QuantifierSome && context.Negative
+	reportElements := !(v.Quantifier == QuantifierEvery && context.Negative)
+
+	passedCount := 0
+	failures := make([]string, 0)
+	for index, element := range elements {
+		elementPassed, elementErrors := v.validateElement(element, context)
+		if elementPassed {
+			passedCount++
+		}
+		if !reportElements || elementPassed != reportPassed {
+			continue
+		}
+
+		actual := strings.Join(elementErrors, "\n")
+		if elementPassed {
+			actual = "passed"
+		}
+		if v.Path == "" {
+			failures = append(failures, v.failInfo(actual, index, -1, context.Negative)...)
+		} else {
+			failures = append(failures, v.failInfo(actual, manifestIndex, index, context.Negative)...)
+		}
+	}
+
+	var holds bool
+	switch v.Quantifier {
+	case QuantifierEvery:
+		holds = passedCount == len(elements)
+	case QuantifierSome:
+		holds = passedCount > 0
+	default:
+		holds = passedCount == 0
+	}
+
+	if holds != context.Negative {
+		return true, []string{}
+	}
+	if len(failures) == 0 {
+		failures = v.failInfo(fmt.Sprintf("%d of %d passed", passedCount, len(elements)), manifestIndex, -1, context.Negative)
+	}
+	return false, failures
+}
+
+// elements returns the elements of the arrays at Path of the manifest.
+// Elements which are not maps, like the strings of args, are wrapped like raw documents to be asserted at path `.`.
+func (v QuantifierValidator) elements(manifest common.K8sManifest) ([]common.K8sManifest, bool, error) {
+	actuals, err := valueutils.GetValueOfSetPath(manifest, v.Path)
+	if err != nil {
+		return nil, false, err
+	}
+
+	elements := make([]common.K8sManifest, 0)
+	for _, actual := range actuals {
+		values, isArray := actual.([]any)
+		if !isArray {
+			values = []any{actual}
+		}
+		for _, value := range values {
+			element, ok := value.(map[string]any)
+			if !ok {
+				element = common.K8sManifest{common.RAW: value}
+			}
+			elements = append(elements, element)
+		}
+	}
+	return elements, len(actuals) > 0, nil
+}
+
+// Validate implement Validatable
+func (v QuantifierValidator) Validate(context *ValidateContext) (bool, []string) {
+	if len(v.Asserts) == 0 {
+		return false, splitInfof(errorFormat, -1, -1, "expected field 'asserts' to be filled")
+	}
+
+	manifests := context.getManifests()
+
+	if v.Path == "" {
+		return v.validateElements(manifests, -1, context)
+	}
+
+	validateSuccess := false
+	validateErrors := make([]string, 0)
+
+	for manifestIndex, manifest := range manifests {
+		manifestSuccess, manifestErrors := v.validateManifest(manifest, manifestIndex, context)
+		validateErrors = append(validateErrors, manifestErrors...)
+		validateSuccess = determineSuccess(manifestIndex, validateSuccess, manifestSuccess)
+
+		if !validateSuccess && context.FailFast {
+			break
+		}
+	}
+
+	if len(manifests) == 0 && !context.Negative {
+		validateErrors = append(validateErrors, v.failInfo("no manifest found", -1, -1, context.Negative)...)
+	} else if len(manifests) == 0 && context.Negative {
+		validateSuccess = true
+	}
+
+	return validateSuccess, validateErrors
+}
+
+func (v QuantifierValidator) validateManifest(manifest common.K8sManifest, manifestIndex int, context *ValidateContext) (bool, []string) {
+	elements, found, err := v.elements(manifest)
+	if err != nil {
+		return false, splitInfof(errorFormat, manifestIndex, -1, err.Error())
+	}
+
+	if !found && !context.Negative {
+		return false, splitInfof(errorFormat, manifestIndex, -1, fmt.Sprintf("unknown path %s", v.Path))
+	}
+	if !found {
+		return true, []string{}
+	}
+
+	return v.validateElements(elements, manifestIndex, context)
+}
@@ -0,0 +1,340 @@
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+	. "github.com/helm-unittest/helm-unittest/pkg/unittest/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+var docToTestQuantifier = `
+kind: Deployment
+metadata:
+  name: my-app
+spec:
+  template:
+    spec:
+      containers:
+        - name: app
+          securityContext:
+            runAsNonRoot: true
+        - name: sidecar
+          securityContext:
+            runAsNonRoot: false
+`
+
+var runAsNonRootAssert = QuantifiedAssertion{
+	AssertType: "equal",
+	Validator:  EqualValidator{Path: "securityContext.runAsNonRoot", Value: true},
+}
+
+func TestQuantifierValidatorWhenOk(t *testing.T) {
+	tests := []struct {
+		quantifier string
+		asserts    []QuantifiedAssertion
+	}{
+		{quantifier: QuantifierEvery, asserts: []QuantifiedAssertion{{AssertType: "exists", Validator: ExistsValidator{Path: "securityContext"}}}},
+		{quantifier: QuantifierSome, asserts: []QuantifiedAssertion{runAsNonRootAssert}},
+		{quantifier: QuantifierNone, asserts: []QuantifiedAssertion{{AssertType: "equal", Validator: EqualValidator{Path: "name", Value: "init"}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.quantifier, func(t *testing.T) {
+			validator := QuantifierValidator{
+				Quantifier: tt.quantifier,
+				Path:       "spec.template.spec.containers",
+				Asserts:    tt.asserts,
+			}
+			pass, diff := validator.Validate(&ValidateContext{
+				Docs: []common.K8sManifest{makeManifest(docToTestQuantifier)},
+			})
+
+			assert.True(t, pass)
+			assert.Equal(t, []string{}, diff)
+		})
+	}
+}
+
+func TestQuantifierValidatorEveryWhenFail(t *testing.T) {
+	validator := QuantifierValidator{
+		Quantifier: QuantifierEvery,
+		Path:       "spec.template.spec.containers",
+		Asserts:    []QuantifiedAssertion{runAsNonRootAssert},
+	}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestQuantifier)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"DocumentIndex:	0",
+		"ValuesIndex:	1",
+		"Path:	spec.template.spec.containers",
+		"Expected every element to pass:",
+		"	equal",
+		"Actual:",
+		"	ValuesIndex:	0",
+		"	Path:	securityContext.runAsNonRoot",
+		"	Expected to equal:",
+		"		true",
+		"	Actual:",
+		"		false",
+		"	Diff:",
+		"		--- Expected",
+		"		+++ Actual",
+		"		@@ -1,2 +1,2 @@",
+		"		-true",
+		"		+false",
+	}, diff)
+}
+
+func TestQuantifierValidatorNoneWhenFail(t *testing.T) {
+	validator := QuantifierValidator{
+		Quantifier: QuantifierNone,
+		Path:       "spec.template.spec.containers",
+		Asserts:    []QuantifiedAssertion{runAsNonRootAssert},
+	}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestQuantifier)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"DocumentIndex:	0",
+		"ValuesIndex:	0",
+		"Path:	spec.template.spec.containers",
+		"Expected none element to pass:",
+		"	equal",
+		"Actual:",
+		"	passed",
+	}, diff)
+}
+
+func TestQuantifierValidatorWhenNoElements(t *testing.T) {
+	for _, quantifier := range []string{QuantifierEvery, QuantifierSome, QuantifierNone} {
+		t.Run(quantifier, func(t *testing.T) {
+			validator := QuantifierValidator{
+				Quantifier: quantifier,
+				Path:       "spec.template.spec.initContainers",
+				Asserts:    []QuantifiedAssertion{runAsNonRootAssert},
+			}
+			manifest := makeManifest(docToTestQuantifier)
+			manifest["spec"].(map[string]any)["template"].(map[string]any)["spec"].(map[string]any)["initContainers"] = []any{}
+			pass, diff := validator.Validate(&ValidateContext{
+				Docs: []common.K8sManifest{manifest},
+			})
+
+			assert.False(t, pass)
+			assert.Equal(t, []string{
+				"DocumentIndex:	0",
+				"Path:	spec.template.spec.initContainers",
+				"Expected " + quantifier + " element to pass:",
+				"	equal",
+				"Actual:",
+				"	no element found",
+			}, diff)
+
+			pass, diff = validator.Validate(&ValidateContext{
+				Docs:     []common.K8sManifest{manifest},
+				Negative: true,
+			})
+
+			assert.True(t, pass)
+			assert.Equal(t, []string{}, diff)
+		})
+	}
+}
+
+func TestQuantifierValidatorWhenNegativeAndOk(t *testing.T) {
+	validator := QuantifierValidator{
+		Quantifier: QuantifierEvery,
+		Path:       "spec.template.spec.containers",
+		Asserts:    []QuantifiedAssertion{runAsNonRootAssert},
+	}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:     []common.K8sManifest{makeManifest(docToTestQuantifier)},
+		Negative: true,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestQuantifierValidatorWhenNegativeAndFail(t *testing.T) {
+	validator := QuantifierValidator{
+		Quantifier: QuantifierSome,
+		Path:       "spec.template.spec.containers",
+		Asserts:    []QuantifiedAssertion{runAsNonRootAssert},
+	}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:     []common.K8sManifest{makeManifest(docToTestQuantifier)},
+		Negative: true,
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"DocumentIndex:	0",
+		"ValuesIndex:	0",
+		"Path:	spec.template.spec.containers",
+		"Expected NOT some element to pass:",
+		"	equal",
+		"Actual:",
+		"	passed",
+	}, diff)
+}
+
+func TestQuantifierValidatorAcrossDocuments(t *testing.T) {
+	validator := QuantifierValidator{
+		Quantifier: QuantifierEvery,
+		Asserts: []QuantifiedAssertion{{
+			AssertType: "exists",
+			Validator:  ExistsValidator{Path: "metadata.labels"},
+		}},
+	}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{
+			makeManifest("metadata:\n  name: a\n  labels:\n    app: a\n"),
+			makeManifest("metadata:\n  name: b\n"),
+		},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"DocumentIndex:	1",
+		"Expected every document to pass:",
+		"	exists",
+		"Actual:",
+		"	Path:	metadata.labels expected to exists",
+	}, diff)
+}
+
+func TestQuantifierValidatorAcrossNoDocuments(t *testing.T) {
+	for _, quantifier := range []string{QuantifierEvery, QuantifierSome, QuantifierNone} {
+		t.Run(quantifier, func(t *testing.T) {
+			validator := QuantifierValidator{
+				Quantifier: quantifier,
+				Asserts: []QuantifiedAssertion{{
+					AssertType: "exists",
+					Validator:  ExistsValidator{Path: "metadata.labels"},
+				}},
+			}
+			pass, diff := validator.Validate(&ValidateContext{Docs: []common.K8sManifest{}})
+
+			assert.False(t, pass)
+			assert.Equal(t, []string{
+				"Expected " + quantifier + " document to pass:",
+				"	exists",
+				"Actual:",
+				"	no manifest found",
+			}, diff)
+
+			pass, diff = validator.Validate(&ValidateContext{Docs: []common.K8sManifest{}, Negative: true})
+
+			assert.True(t, pass)
+			assert.Equal(t, []string{}, diff)
+		})
+	}
+}
+
+var docToTestQuantifierScalars = `
+kind: Deployment
+metadata:
+  name: my-app
+spec:
+  template:
+    spec:
+      containers:
+        - name: app
+          args:
+            - --verbose
+            - --port=8080
+`
+
+func TestQuantifierValidatorWhenScalarElementsOk(t *testing.T) {
+	tests := []struct {
+		quantifier string
+		assert     QuantifiedAssertion
+	}{
+		{quantifier: QuantifierEvery, assert: QuantifiedAssertion{AssertType: "matchRegex", Validator: MatchRegexValidator{Path: ".", Pattern: "^--"}}},
+		{quantifier: QuantifierSome, assert: QuantifiedAssertion{AssertType: "equal", Validator: EqualValidator{Path: ".", Value: "--verbose"}}},
+		{quantifier: QuantifierNone, assert: QuantifiedAssertion{AssertType: "equal", Validator: EqualValidator{Path: ".", Value: "--debug"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.quantifier, func(t *testing.T) {
+			validator := QuantifierValidator{
+				Quantifier: tt.quantifier,
+				Path:       "spec.template.spec.containers[0].args",
+				Asserts:    []QuantifiedAssertion{tt.assert},
+			}
+			pass, diff := validator.Validate(&ValidateContext{
+				Docs: []common.K8sManifest{makeManifest(docToTestQuantifierScalars)},
+			})
+
+			assert.True(t, pass)
+			assert.Equal(t, []string{}, diff)
+		})
+	}
+}
+
+func TestQuantifierValidatorWhenScalarElementsFail(t *testing.T) {
+	validator := QuantifierValidator{
+		Quantifier: QuantifierEvery,
+		Path:       "spec.template.spec.containers[0].args",
+		Asserts: []QuantifiedAssertion{{
+			AssertType: "matchRegex",
+			Validator:  MatchRegexValidator{Path: ".", Pattern: "^--port="},
+		}},
+	}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestQuantifierScalars)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"DocumentIndex:	0",
+		"ValuesIndex:	0",
+		"Path:	spec.template.spec.containers[0].args",
+		"Expected every element to pass:",
+		"	matchRegex",
+		"Actual:",
+		"	ValuesIndex:	0",
+		"	Path:	.",
+		"	Expected to match:",
+		"		^--port=",
+		"	Actual:",
+		"		--verbose",
+	}, diff)
+}
+
+func TestQuantifierValidatorWhenUnknownPath(t *testing.T) {
+	validator := QuantifierValidator{
+		Quantifier: QuantifierEvery,
+		Path:       "spec.unknown",
+		Asserts:    []QuantifiedAssertion{runAsNonRootAssert},
+	}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestQuantifier)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"DocumentIndex:	0",
+		"Error:",
+		"	unknown path spec.unknown",
+	}, diff)
+}
+
+func TestQuantifierValidatorWhenAssertsEmpty(t *testing.T) {
+	validator := QuantifierValidator{Quantifier: QuantifierEvery, Path: "spec.template.spec.containers"}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{makeManifest(docToTestQuantifier)},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Error:",
+		"	expected field 'asserts' to be filled",
+	}, diff)
+}
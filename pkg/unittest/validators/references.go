@@ -0,0 +1,113 @@
+package validators
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+)
+
+// nestedMap returns the map found by walking the fields, or nil when it does not exist.
+func nestedMap(value any, fields ...string) map[string]any {
+	current, _ := value.(map[string]any)
+	for _, field := range fields {
+		if current == nil {
+			return nil
+		}
+		current, _ = current[field].(map[string]any)
+	}
+	return current
+}
+
+// nestedSlice returns the slice found by walking the fields, or nil when it does not exist.
+func nestedSlice(value any, fields ...string) []any {
+	if len(fields) == 0 {
+		slice, _ := value.([]any)
+		return slice
+	}
+	slice, _ := nestedMap(value, fields[:len(fields)-1]...)[fields[len(fields)-1]].([]any)
+	return slice
+}
+
+// nestedString returns the string found by walking the fields, or an empty string when it does not exist.
+func nestedString(value any, fields ...string) string {
+	if len(fields) == 0 {
+		return ""
+	}
+	str, _ := nestedMap(value, fields[:len(fields)-1]...)[fields[len(fields)-1]].(string)
+	return str
+}
+
+// manifestNamespace returns the namespace of the manifest, the release namespace when it is not set
+// as it will be installed in the namespace of the release.
+func manifestNamespace(manifest common.K8sManifest, releaseNamespace string) string {
+	if namespace := nestedString(manifest, "metadata", "namespace"); namespace != "" {
+		return namespace
+	}
+	return releaseNamespace
+}
+
+// findResources returns all objects of the kind with the name in the namespace,
+// objects without a namespace are in the release namespace.
+func findResources(objects []common.K8sManifest, kind, namespace, name, releaseNamespace string) []common.K8sManifest {
+	found := make([]common.K8sManifest, 0)
+	for _, object := range objects {
+		if nestedString(object, "kind") == kind &&
+			nestedString(object, "metadata", "name") == name &&
+			manifestNamespace(object, releaseNamespace) == namespace {
+			found = append(found, object)
+		}
+	}
+	return found
+}
+
+// podTemplate returns the pod template metadata and spec of a workload.
+// Pods are returned as is, other kinds without a pod template return false.
+func podTemplate(manifest common.K8sManifest) (map[string]any, map[string]any, bool) {
+	var template map[string]any
+	switch nestedString(manifest, "kind") {
+	case "Pod":
+		template = manifest
+	case "Deployment", "StatefulSet", "DaemonSet", "ReplicaSet", "ReplicationController", "Job":
+		template = nestedMap(manifest, "spec", "template")
+	case "CronJob":
+		template = nestedMap(manifest, "spec", "jobTemplate", "spec", "template")
+	}
+
+	if template == nil {
+		return nil, nil, false
+	}
+	return nestedMap(template, "metadata"), nestedMap(template, "spec"), true
+}
+
+// podContainers returns all containers, init containers and ephemeral containers of a pod spec.
+func podContainers(podSpec map[string]any) []any {
+	containers := make([]any, 0)
+	for _, field := range []string{"initContainers", "containers", "ephemeralContainers"} {
+		containers = append(containers, nestedSlice(podSpec, field)...)
+	}
+	return containers
+}
+
+// allObjects combines the rendered documents with the objects which already exist in the cluster.
+func (c *ValidateContext) allObjects() []common.K8sManifest {
+	objects := make([]common.K8sManifest, 0, len(c.Docs)+len(c.ClusterObjects))
+	objects = append(objects, c.getManifests()...)
+	return append(objects, c.ClusterObjects...)
+}
+
+// referencesFailInfo creates the failure information for the reference validators.
+func referencesFailInfo(not bool, subject string, problems []string) []string {
+	if not {
+		return splitInfof(fmt.Sprintf("Expected NOT %s, all resolved", subject), -1, -1)
+	}
+	return splitInfof(
+		`
+Expected `+subject+`, unresolved:
+%s
+`,
+		-1,
+		-1,
+		strings.Join(problems, "\n"),
+	)
+}
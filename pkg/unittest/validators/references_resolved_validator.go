@@ -0,0 +1,121 @@
+package validators
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ReferencesResolvedValidator validate whether every configMapKeyRef, secretKeyRef,
+// envFrom, volume configMap/secret and serviceAccountName of a workload refers to an object
+// rendered in the release or existing in the cluster.
+// References marked as optional are not required to resolve.
+type ReferencesResolvedValidator struct{}
+
+type objectReference struct {
+	kind  string
+	name  string
+	field string
+}
+
+// isOptionalReference checks if the reference source is marked as optional.
+func isOptionalReference(source map[string]any) bool {
+	isOptional, _ := source["optional"].(bool)
+	return isOptional
+}
+
+// containerReferences returns the ConfigMap and Secret references of the containers.
+func (v ReferencesResolvedValidator) containerReferences(podSpec map[string]any) []objectReference {
+	references := make([]objectReference, 0)
+	for _, container := range podContainers(podSpec) {
+		containerName := nestedString(container, "name")
+
+		for _, env := range nestedSlice(container, "env") {
+			if ref := nestedMap(env, "valueFrom", "configMapKeyRef"); ref != nil && !isOptionalReference(ref) {
+				field := fmt.Sprintf("container %s env %s configMapKeyRef", containerName, nestedString(env, "name"))
+				references = append(references, objectReference{"ConfigMap", nestedString(ref, "name"), field})
+			}
+			if ref := nestedMap(env, "valueFrom", "secretKeyRef"); ref != nil && !isOptionalReference(ref) {
+				field := fmt.Sprintf("container %s env %s secretKeyRef", containerName, nestedString(env, "name"))
+				references = append(references, objectReference{"Secret", nestedString(ref, "name"), field})
+			}
+		}
+
+		for _, envFrom := range nestedSlice(container, "envFrom") {
+			if ref := nestedMap(envFrom, "configMapRef"); ref != nil && !isOptionalReference(ref) {
+				field := fmt.Sprintf("container %s envFrom configMapRef", containerName)
+				references = append(references, objectReference{"ConfigMap", nestedString(ref, "name"), field})
+			}
+			if ref := nestedMap(envFrom, "secretRef"); ref != nil && !isOptionalReference(ref) {
+				field := fmt.Sprintf("container %s envFrom secretRef", containerName)
+				references = append(references, objectReference{"Secret", nestedString(ref, "name"), field})
+			}
+		}
+	}
+	return references
+}
+
+// volumeReferences returns the ConfigMap and Secret references of the volumes, including projected volumes.
+func (v ReferencesResolvedValidator) volumeReferences(podSpec map[string]any) []objectReference {
+	references := make([]objectReference, 0)
+	for _, volume := range nestedSlice(podSpec, "volumes") {
+		field := fmt.Sprintf("volume %s", nestedString(volume, "name"))
+
+		if ref := nestedMap(volume, "configMap"); ref != nil && !isOptionalReference(ref) {
+			references = append(references, objectReference{"ConfigMap", nestedString(ref, "name"), field})
+		}
+		if ref := nestedMap(volume, "secret"); ref != nil && !isOptionalReference(ref) {
+			references = append(references, objectReference{"Secret", nestedString(ref, "secretName"), field})
+		}
+
+		for _, source := range nestedSlice(volume, "projected", "sources") {
+			if ref := nestedMap(source, "configMap"); ref != nil && !isOptionalReference(ref) {
+				references = append(references, objectReference{"ConfigMap", nestedString(ref, "name"), field})
+			}
+			if ref := nestedMap(source, "secret"); ref != nil && !isOptionalReference(ref) {
+				references = append(references, objectReference{"Secret", nestedString(ref, "name"), field})
+			}
+		}
+	}
+	return references
+}
+
+// references returns all references of the pod spec to other objects.
+func (v ReferencesResolvedValidator) references(podSpec map[string]any) []objectReference {
+	references := v.containerReferences(podSpec)
+	references = append(references, v.volumeReferences(podSpec)...)
+
+	// The default service account is created by Kubernetes for every namespace.
+	if serviceAccountName := nestedString(podSpec, "serviceAccountName"); serviceAccountName != "" && serviceAccountName != "default" {
+		references = append(references, objectReference{"ServiceAccount", serviceAccountName, "serviceAccountName"})
+	}
+	return references
+}
+
+// Validate implement Validatable
+func (v ReferencesResolvedValidator) Validate(context *ValidateContext) (bool, []string) {
+	objects := context.allObjects()
+	releaseNamespace := context.releaseNamespace()
+
+	problems := make([]string, 0)
+	for _, manifest := range context.getManifests() {
+		_, podSpec, ok := podTemplate(manifest)
+		if !ok {
+			continue
+		}
+
+		for _, reference := range v.references(podSpec) {
+			if len(findResources(objects, reference.kind, manifestNamespace(manifest, releaseNamespace), reference.name, releaseNamespace)) == 0 {
+				problems = append(problems, fmt.Sprintf("%s %s: %s/%s", resourceIdentifier(manifest, releaseNamespace), reference.field, reference.kind, reference.name))
+			}
+		}
+	}
+
+	log.WithField("validator", "references_resolved").Debugln("unresolved references:", problems)
+
+	if (len(problems) == 0) == context.Negative {
+		return false, referencesFailInfo(context.Negative, "references to be resolved", problems)
+	}
+
+	return true, []string{}
+}
@@ -0,0 +1,194 @@
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+	. "github.com/helm-unittest/helm-unittest/pkg/unittest/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+var docToTestReferencesResolvedDeployment = `
+kind: Deployment
+metadata:
+  name: web
+spec:
+  template:
+    spec:
+      serviceAccountName: web
+      initContainers:
+        - name: init
+          envFrom:
+            - secretRef:
+                name: web-secret
+      containers:
+        - name: web
+          env:
+            - name: LEVEL
+              valueFrom:
+                configMapKeyRef:
+                  name: web-config
+                  key: level
+            - name: PASSWORD
+              valueFrom:
+                secretKeyRef:
+                  name: web-secret
+                  key: password
+            - name: OPTIONAL
+              valueFrom:
+                configMapKeyRef:
+                  name: optional-config
+                  key: optional
+                  optional: true
+      volumes:
+        - name: config
+          configMap:
+            name: web-config
+        - name: certs
+          secret:
+            secretName: web-certs
+        - name: projected
+          projected:
+            sources:
+              - configMap:
+                  name: web-config
+`
+
+var docToTestReferencesResolvedConfigMap = `
+kind: ConfigMap
+metadata:
+  name: web-config
+`
+
+var docToTestReferencesResolvedSecret = `
+kind: Secret
+metadata:
+  name: web-secret
+`
+
+var docToTestReferencesResolvedCerts = `
+kind: Secret
+metadata:
+  name: web-certs
+`
+
+var docToTestReferencesResolvedServiceAccount = `
+kind: ServiceAccount
+metadata:
+  name: web
+`
+
+func TestReferencesResolvedValidatorWhenOk(t *testing.T) {
+	validator := ReferencesResolvedValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{
+			makeManifest(docToTestReferencesResolvedDeployment),
+			makeManifest(docToTestReferencesResolvedConfigMap),
+			makeManifest(docToTestReferencesResolvedSecret),
+			makeManifest(docToTestReferencesResolvedServiceAccount),
+		},
+		ClusterObjects: []common.K8sManifest{
+			makeManifest(docToTestReferencesResolvedCerts),
+		},
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestReferencesResolvedValidatorWhenFail(t *testing.T) {
+	validator := ReferencesResolvedValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{
+			makeManifest(docToTestReferencesResolvedDeployment),
+			makeManifest(docToTestReferencesResolvedConfigMap),
+		},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Expected references to be resolved, unresolved:",
+		"	Deployment/web container init envFrom secretRef: Secret/web-secret",
+		"	Deployment/web container web env PASSWORD secretKeyRef: Secret/web-secret",
+		"	Deployment/web volume certs: Secret/web-certs",
+		"	Deployment/web serviceAccountName: ServiceAccount/web",
+	}, diff)
+}
+
+func TestReferencesResolvedValidatorWhenOtherNamespaceFail(t *testing.T) {
+	validator := ReferencesResolvedValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{
+			makeManifest(`
+kind: Pod
+metadata:
+  name: web
+  namespace: foo
+spec:
+  serviceAccountName: web
+`),
+		},
+		ClusterObjects: []common.K8sManifest{
+			makeManifest(`
+kind: ServiceAccount
+metadata:
+  name: web
+  namespace: bar
+`),
+		},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Expected references to be resolved, unresolved:",
+		"	Pod/foo/web serviceAccountName: ServiceAccount/web",
+	}, diff)
+}
+
+func TestReferencesResolvedValidatorWithReleaseNamespace(t *testing.T) {
+	pod := makeManifest(`
+kind: Pod
+metadata:
+  name: web
+spec:
+  serviceAccountName: web
+`)
+	tests := []struct {
+		name      string
+		namespace string
+		passed    bool
+	}{
+		{name: "release namespace", namespace: "foo", passed: true},
+		{name: "other namespace", namespace: "bar", passed: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := ReferencesResolvedValidator{}
+			pass, _ := validator.Validate(&ValidateContext{
+				Docs: []common.K8sManifest{pod},
+				ClusterObjects: []common.K8sManifest{
+					makeManifest("kind: ServiceAccount\nmetadata:\n  name: web\n  namespace: " + tt.namespace + "\n"),
+				},
+				RenderContext: RenderContext{Release: map[string]any{"Namespace": "foo"}},
+			})
+
+			assert.Equal(t, tt.passed, pass)
+		})
+	}
+}
+
+func TestReferencesResolvedValidatorWhenNegativeAndFail(t *testing.T) {
+	validator := ReferencesResolvedValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{
+			makeManifest(docToTestReferencesResolvedConfigMap),
+		},
+		Negative: true,
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Expected NOT references to be resolved, all resolved",
+	}, diff)
+}
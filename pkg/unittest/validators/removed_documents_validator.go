@@ -0,0 +1,20 @@
+package validators
+
+// RemovedDocumentsValidator validate whether the documents removed compared to the baseline of `diffFrom`
+// are exactly the Resources, referenced as Kind, Kind/name or Kind/namespace/name, and have the Count, if set.
+// Without Resources and Count, documents should be removed.
+type RemovedDocumentsValidator struct {
+	Resources []string
+	Count     *int
+}
+
+// Validate implement Validatable
+func (v RemovedDocumentsValidator) Validate(context *ValidateContext) (bool, []string) {
+	diff, err := context.documentsDiff()
+	if err != nil {
+		return false, splitInfof(errorFormat, -1, -1, err.Error())
+	}
+
+	expected := documentsOfDiff{change: "removed", resources: v.Resources, count: v.Count, releaseNamespace: diff.releaseNamespace}
+	return expected.validate(diff.removed, context.Negative)
+}
@@ -0,0 +1,64 @@
+package validators_test
+
+import (
+	"testing"
+
+	. "github.com/helm-unittest/helm-unittest/pkg/unittest/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemovedDocumentsValidatorWhenOk(t *testing.T) {
+	validator := RemovedDocumentsValidator{Resources: []string{"ConfigMap/baz/bar"}}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:         docsToTestDiff,
+		BaselineDocs: &baselineDocsToTestDiff,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestRemovedDocumentsValidatorWhenNegativeAndOk(t *testing.T) {
+	validator := RemovedDocumentsValidator{Resources: []string{"Service"}}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:         docsToTestDiff,
+		BaselineDocs: &baselineDocsToTestDiff,
+		Negative:     true,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestRemovedDocumentsValidatorWhenFail(t *testing.T) {
+	count := 0
+	validator := RemovedDocumentsValidator{Count: &count}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:         docsToTestDiff,
+		BaselineDocs: &baselineDocsToTestDiff,
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Expected removed documents:",
+		"	count: 0",
+		"Actual:",
+		"	ConfigMap/baz/bar",
+	}, diff)
+}
+
+func TestRemovedDocumentsValidatorWhenNothingRemovedFail(t *testing.T) {
+	validator := RemovedDocumentsValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:         baselineDocsToTestDiff,
+		BaselineDocs: &baselineDocsToTestDiff,
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Expected removed documents:",
+		"	any documents",
+		"Actual:",
+		"	no documents",
+	}, diff)
+}
@@ -0,0 +1,61 @@
+package validators
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+)
+
+// ServiceSelectsPodsValidator validate whether the selector of every Service
+// matches the pod template labels of at least one workload.
+type ServiceSelectsPodsValidator struct{}
+
+// selectsPods checks if the selector matches the pod labels of one of the objects in the namespace of the service,
+// objects without a namespace are in the release namespace.
+func (v ServiceSelectsPodsValidator) selectsPods(service common.K8sManifest, selector map[string]any, objects []common.K8sManifest, releaseNamespace string) bool {
+	for _, object := range objects {
+		metadata, _, ok := podTemplate(object)
+		if !ok || manifestNamespace(service, releaseNamespace) != manifestNamespace(object, releaseNamespace) {
+			continue
+		}
+
+		labels := nestedMap(metadata, "labels")
+		if labels != nil && validateSubset(labels, selector) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate implement Validatable
+func (v ServiceSelectsPodsValidator) Validate(context *ValidateContext) (bool, []string) {
+	objects := context.allObjects()
+	releaseNamespace := context.releaseNamespace()
+
+	problems := make([]string, 0)
+	for _, manifest := range context.getManifests() {
+		if nestedString(manifest, "kind") != "Service" {
+			continue
+		}
+
+		// Services without a selector are managed manually, for example with EndpointSlices.
+		selector := nestedMap(manifest, "spec", "selector")
+		if len(selector) == 0 {
+			continue
+		}
+
+		if !v.selectsPods(manifest, selector, objects, releaseNamespace) {
+			problems = append(problems, fmt.Sprintf("%s selector %v", resourceIdentifier(manifest, releaseNamespace), selector))
+		}
+	}
+
+	log.WithField("validator", "service_selects_pods").Debugln("unresolved selectors:", problems)
+
+	if (len(problems) == 0) == context.Negative {
+		return false, referencesFailInfo(context.Negative, "services to select pods", problems)
+	}
+
+	return true, []string{}
+}
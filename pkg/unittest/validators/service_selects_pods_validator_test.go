@@ -0,0 +1,153 @@
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+	. "github.com/helm-unittest/helm-unittest/pkg/unittest/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+var docToTestServiceSelectsPodsService = `
+kind: Service
+metadata:
+  name: web
+spec:
+  selector:
+    app: web
+`
+
+var docToTestServiceSelectsPodsHeadless = `
+kind: Service
+metadata:
+  name: external
+spec:
+  ports:
+    - port: 80
+`
+
+var docToTestServiceSelectsPodsDeployment = `
+kind: Deployment
+metadata:
+  name: web
+spec:
+  template:
+    metadata:
+      labels:
+        app: web
+        tier: frontend
+`
+
+var docToTestServiceSelectsPodsCronJob = `
+kind: CronJob
+metadata:
+  name: web
+spec:
+  jobTemplate:
+    spec:
+      template:
+        metadata:
+          labels:
+            app: cron
+`
+
+var docToTestServiceSelectsPodsPod = `
+kind: Pod
+metadata:
+  name: web
+  labels:
+    app: web
+`
+
+func TestServiceSelectsPodsValidatorWhenOk(t *testing.T) {
+	validator := ServiceSelectsPodsValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{
+			makeManifest(docToTestServiceSelectsPodsService),
+			makeManifest(docToTestServiceSelectsPodsHeadless),
+			makeManifest(docToTestServiceSelectsPodsDeployment),
+		},
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestServiceSelectsPodsValidatorWhenClusterObjectOk(t *testing.T) {
+	validator := ServiceSelectsPodsValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{
+			makeManifest(docToTestServiceSelectsPodsService),
+		},
+		ClusterObjects: []common.K8sManifest{
+			makeManifest(docToTestServiceSelectsPodsPod),
+		},
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestServiceSelectsPodsValidatorWhenOtherNamespaceFail(t *testing.T) {
+	validator := ServiceSelectsPodsValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{
+			makeManifest(docToTestServiceSelectsPodsService),
+		},
+		ClusterObjects: []common.K8sManifest{
+			makeManifest("kind: Pod\nmetadata:\n  name: web\n  namespace: bar\n  labels:\n    app: web\n"),
+		},
+		RenderContext: RenderContext{Release: map[string]any{"Namespace": "foo"}},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Expected services to select pods, unresolved:",
+		"	Service/foo/web selector map[app:web]",
+	}, diff)
+}
+
+func TestServiceSelectsPodsValidatorWhenFail(t *testing.T) {
+	validator := ServiceSelectsPodsValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{
+			makeManifest(docToTestServiceSelectsPodsService),
+			makeManifest(docToTestServiceSelectsPodsCronJob),
+		},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Expected services to select pods, unresolved:",
+		"	Service/web selector map[app:web]",
+	}, diff)
+}
+
+func TestServiceSelectsPodsValidatorWhenNegativeAndOk(t *testing.T) {
+	validator := ServiceSelectsPodsValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{
+			makeManifest(docToTestServiceSelectsPodsService),
+		},
+		Negative: true,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestServiceSelectsPodsValidatorWhenNegativeAndFail(t *testing.T) {
+	validator := ServiceSelectsPodsValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{
+			makeManifest(docToTestServiceSelectsPodsService),
+			makeManifest(docToTestServiceSelectsPodsDeployment),
+		},
+		Negative: true,
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Expected NOT services to select pods, all resolved",
+	}, diff)
+}
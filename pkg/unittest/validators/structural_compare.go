@@ -0,0 +1,219 @@
+package validators
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+
+	"github.com/helm-unittest/helm-unittest/pkg/unittest/valueutils"
+)
+
+// plainKeyRegex the map keys which can be used in a path without brackets.
+var plainKeyRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// structuralCompare compares values structurally, optionally ignoring the order of lists
+// and the values at the IgnorePaths, which are relative to the compared values.
+type structuralCompare struct {
+	IgnoreOrder bool
+	IgnorePaths []string
+}
+
+// normalize returns a copy of the value without the values at the IgnorePaths.
+func (c structuralCompare) normalize(value any) (any, error) {
+	var err error
+	for _, path := range c.IgnorePaths {
+		if value, err = valueutils.RemoveValuesOfSetPath(value, path); err != nil {
+			return nil, err
+		}
+	}
+	return value, nil
+}
+
+// equal returns whether the values are equal, lists in any order when IgnoreOrder is set.
+func (c structuralCompare) equal(expected, actual any) bool {
+	if !c.IgnoreOrder {
+		return reflect.DeepEqual(expected, actual)
+	}
+
+	switch exp := expected.(type) {
+	case map[string]any:
+		act, ok := actual.(map[string]any)
+		if !ok || len(exp) != len(act) {
+			return false
+		}
+		for key, value := range exp {
+			actualValue, found := act[key]
+			if !found || !c.equal(value, actualValue) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		act, ok := actual.([]any)
+		if !ok || len(exp) != len(act) {
+			return false
+		}
+		unmatchedExpected, _ := c.unmatchedElements(exp, act)
+		return len(unmatchedExpected) == 0
+	default:
+		return reflect.DeepEqual(expected, actual)
+	}
+}
+
+// subset returns whether the actual map contains all keys of the content with equal values.
+func (c structuralCompare) subset(actual, content map[string]any) bool {
+	for key := range content {
+		if !c.equal(content[key], actual[key]) {
+			return false
+		}
+	}
+	return true
+}
+
+// unmatchedElements returns the indexes of the expected and actual elements which have no equal counterpart.
+func (c structuralCompare) unmatchedElements(expected, actual []any) ([]int, []int) {
+	matched := make([]bool, len(actual))
+	unmatchedExpected := make([]int, 0)
+
+	for expectedIndex, expectedElement := range expected {
+		found := false
+		for actualIndex, actualElement := range actual {
+			if !matched[actualIndex] && c.equal(expectedElement, actualElement) {
+				matched[actualIndex] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			unmatchedExpected = append(unmatchedExpected, expectedIndex)
+		}
+	}
+
+	unmatchedActual := make([]int, 0)
+	for actualIndex, isMatched := range matched {
+		if !isMatched {
+			unmatchedActual = append(unmatchedActual, actualIndex)
+		}
+	}
+	return unmatchedExpected, unmatchedActual
+}
+
+// differences returns the added, removed and changed paths of the actual value compared to the expected value.
+func (c structuralCompare) differences(expected, actual any, path string) []string {
+	switch exp := expected.(type) {
+	case map[string]any:
+		if act, ok := actual.(map[string]any); ok {
+			return c.mapDifferences(exp, act, path)
+		}
+	case []any:
+		if act, ok := actual.([]any); ok {
+			return c.listDifferences(exp, act, path)
+		}
+	}
+
+	if c.equal(expected, actual) {
+		return []string{}
+	}
+	return []string{fmt.Sprintf("changed %s: expected %s, got %s", displayPath(path), compactValue(expected), compactValue(actual))}
+}
+
+func (c structuralCompare) mapDifferences(expected, actual map[string]any, path string) []string {
+	keys := make([]string, 0, len(expected)+len(actual))
+	for key := range expected {
+		keys = append(keys, key)
+	}
+	for key := range actual {
+		if _, found := expected[key]; !found {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	differences := make([]string, 0)
+	for _, key := range keys {
+		keyPath := childPath(path, key)
+		expectedValue, inExpected := expected[key]
+		actualValue, inActual := actual[key]
+		switch {
+		case !inActual:
+			differences = append(differences, fmt.Sprintf("removed %s: %s", keyPath, compactValue(expectedValue)))
+		case !inExpected:
+			differences = append(differences, fmt.Sprintf("added %s: %s", keyPath, compactValue(actualValue)))
+		default:
+			differences = append(differences, c.differences(expectedValue, actualValue, keyPath)...)
+		}
+	}
+	return differences
+}
+
+func (c structuralCompare) listDifferences(expected, actual []any, path string) []string {
+	differences := make([]string, 0)
+
+	if c.IgnoreOrder {
+		unmatchedExpected, unmatchedActual := c.unmatchedElements(expected, actual)
+		for _, index := range unmatchedExpected {
+			differences = append(differences, fmt.Sprintf("removed %s: %s", indexPath(path, index), compactValue(expected[index])))
+		}
+		for _, index := range unmatchedActual {
+			differences = append(differences, fmt.Sprintf("added %s: %s", indexPath(path, index), compactValue(actual[index])))
+		}
+		return differences
+	}
+
+	for index := 0; index < max(len(expected), len(actual)); index++ {
+		switch {
+		case index >= len(actual):
+			differences = append(differences, fmt.Sprintf("removed %s: %s", indexPath(path, index), compactValue(expected[index])))
+		case index >= len(expected):
+			differences = append(differences, fmt.Sprintf("added %s: %s", indexPath(path, index), compactValue(actual[index])))
+		default:
+			differences = append(differences, c.differences(expected[index], actual[index], indexPath(path, index))...)
+		}
+	}
+	return differences
+}
+
+// isStructured returns whether the value is a map or a list.
+func isStructured(value any) bool {
+	switch value.(type) {
+	case map[string]any, []any:
+		return true
+	default:
+		return false
+	}
+}
+
+// childPath returns the path of the key in the map at the path.
+func childPath(path, key string) string {
+	if !plainKeyRegex.MatchString(key) {
+		return fmt.Sprintf("%s[%q]", path, key)
+	}
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// indexPath returns the path of the index in the list at the path.
+func indexPath(path string, index int) string {
+	return fmt.Sprintf("%s[%d]", path, index)
+}
+
+// displayPath returns the path, or the root of the compared values when empty.
+func displayPath(path string) string {
+	if path == "" {
+		return "."
+	}
+	return path
+}
+
+// compactValue returns the value on a single line.
+func compactValue(value any) string {
+	compact, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(compact)
+}
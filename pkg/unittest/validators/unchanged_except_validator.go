@@ -0,0 +1,60 @@
+package validators
+
+import (
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// UnchangedExceptValidator validate whether the documents of the baseline of `diffFrom` are unchanged,
+// except for the values at the Paths. Removed documents are changes as well, while added documents are not,
+// those are validated with AddedDocumentsValidator.
+type UnchangedExceptValidator struct {
+	Paths []string
+}
+
+func (v UnchangedExceptValidator) failInfo(changes []string, not bool) []string {
+	actual := strings.Join(changes, "\n")
+
+	log.WithField("validator", "unchanged_except").Debugln("actual content:", actual)
+
+	if not {
+		return splitInfof("Expected NOT documents to be unchanged, no other changes found", -1, -1)
+	}
+	return splitInfof(
+		`
+Expected documents to be unchanged, changes found:
+%s
+`,
+		-1,
+		-1,
+		actual,
+	)
+}
+
+// Validate implement Validatable
+func (v UnchangedExceptValidator) Validate(context *ValidateContext) (bool, []string) {
+	diff, err := context.documentsDiff()
+	if err != nil {
+		return false, splitInfof(errorFormat, -1, -1, err.Error())
+	}
+
+	changes := make([]string, 0)
+	for _, document := range diff.removed {
+		changes = append(changes, fmt.Sprintf("%s: removed", document.identifier))
+	}
+	for _, document := range diff.changed {
+		for _, path := range document.paths {
+			if !pathAllowed(path, v.Paths) {
+				changes = append(changes, fmt.Sprintf("%s: %s", document.identifier, path))
+			}
+		}
+	}
+
+	if (len(changes) == 0) == context.Negative {
+		return false, v.failInfo(changes, context.Negative)
+	}
+
+	return true, []string{}
+}
@@ -0,0 +1,73 @@
+package validators_test
+
+import (
+	"testing"
+
+	. "github.com/helm-unittest/helm-unittest/pkg/unittest/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnchangedExceptValidatorWhenOk(t *testing.T) {
+	baseline := baselineDocsToTestDiff[:2]
+	validator := UnchangedExceptValidator{Paths: []string{"spec.replicas"}}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:         docsToTestDiff,
+		BaselineDocs: &baseline,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestUnchangedExceptValidatorWithParentPathWhenOk(t *testing.T) {
+	baseline := baselineDocsToTestDiff[:2]
+	validator := UnchangedExceptValidator{Paths: []string{"spec"}}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:         docsToTestDiff,
+		BaselineDocs: &baseline,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestUnchangedExceptValidatorWhenNegativeAndOk(t *testing.T) {
+	validator := UnchangedExceptValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:         docsToTestDiff,
+		BaselineDocs: &baselineDocsToTestDiff,
+		Negative:     true,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestUnchangedExceptValidatorWhenFail(t *testing.T) {
+	validator := UnchangedExceptValidator{Paths: []string{"spec.template"}}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:         docsToTestDiff,
+		BaselineDocs: &baselineDocsToTestDiff,
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Expected documents to be unchanged, changes found:",
+		"	ConfigMap/baz/bar: removed",
+		"	Deployment/foo: spec.replicas",
+	}, diff)
+}
+
+func TestUnchangedExceptValidatorWhenNegativeFail(t *testing.T) {
+	validator := UnchangedExceptValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs:         baselineDocsToTestDiff,
+		BaselineDocs: &baselineDocsToTestDiff,
+		Negative:     true,
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Expected NOT documents to be unchanged, no other changes found",
+	}, diff)
+}
@@ -0,0 +1,59 @@
+package validators
+
+import (
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// UniqueResourceNamesValidator validate whether every combination of kind, namespace and name
+// is rendered only once, as duplicates fail the installation of the release.
+type UniqueResourceNamesValidator struct{}
+
+func (v UniqueResourceNamesValidator) failInfo(duplicates []string, not bool) []string {
+	actual := strings.Join(duplicates, "\n")
+
+	log.WithField("validator", "unique_resource_names").Debugln("actual content:", actual)
+
+	if not {
+		return splitInfof("Expected NOT resource names to be unique, no duplicates found", -1, -1)
+	}
+	return splitInfof(
+		`
+Expected resource names to be unique, duplicates found:
+%s
+`,
+		-1,
+		-1,
+		actual,
+	)
+}
+
+// Validate implement Validatable
+func (v UniqueResourceNamesValidator) Validate(context *ValidateContext) (bool, []string) {
+	manifests := context.getManifests()
+
+	counts := make(map[string]int)
+	identifiers := make([]string, 0)
+	for _, manifest := range manifests {
+		identifier := resourceIdentifier(manifest, context.releaseNamespace())
+		if counts[identifier] == 0 {
+			identifiers = append(identifiers, identifier)
+		}
+		counts[identifier]++
+	}
+
+	duplicates := make([]string, 0)
+	for _, identifier := range identifiers {
+		if counts[identifier] > 1 {
+			duplicates = append(duplicates, fmt.Sprintf("%s (%d documents)", identifier, counts[identifier]))
+		}
+	}
+
+	if (len(duplicates) == 0) == context.Negative {
+		return false, v.failInfo(duplicates, context.Negative)
+	}
+
+	return true, []string{}
+}
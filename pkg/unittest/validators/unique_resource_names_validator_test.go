@@ -0,0 +1,108 @@
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+	. "github.com/helm-unittest/helm-unittest/pkg/unittest/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+var docToTestUniqueResourceNames1 = `
+kind: ConfigMap
+metadata:
+  name: foo
+`
+
+var docToTestUniqueResourceNames2 = `
+kind: ConfigMap
+metadata:
+  name: foo
+  namespace: bar
+`
+
+var docToTestUniqueResourceNames3 = `
+kind: Secret
+metadata:
+  name: foo
+`
+
+func TestUniqueResourceNamesValidatorWhenOk(t *testing.T) {
+	validator := UniqueResourceNamesValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{
+			makeManifest(docToTestUniqueResourceNames1),
+			makeManifest(docToTestUniqueResourceNames2),
+			makeManifest(docToTestUniqueResourceNames3),
+		},
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestUniqueResourceNamesValidatorWhenFail(t *testing.T) {
+	validator := UniqueResourceNamesValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{
+			makeManifest(docToTestUniqueResourceNames1),
+			makeManifest(docToTestUniqueResourceNames2),
+			makeManifest(docToTestUniqueResourceNames1),
+			makeManifest(docToTestUniqueResourceNames2),
+			makeManifest(docToTestUniqueResourceNames1),
+		},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Expected resource names to be unique, duplicates found:",
+		"	ConfigMap/foo (3 documents)",
+		"	ConfigMap/bar/foo (2 documents)",
+	}, diff)
+}
+
+func TestUniqueResourceNamesValidatorWhenReleaseNamespaceFail(t *testing.T) {
+	validator := UniqueResourceNamesValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{
+			makeManifest(docToTestUniqueResourceNames1),
+			makeManifest(docToTestUniqueResourceNames2),
+		},
+		RenderContext: RenderContext{Release: map[string]any{"Namespace": "bar"}},
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Expected resource names to be unique, duplicates found:",
+		"	ConfigMap/bar/foo (2 documents)",
+	}, diff)
+}
+
+func TestUniqueResourceNamesValidatorWhenNegativeAndOk(t *testing.T) {
+	validator := UniqueResourceNamesValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{
+			makeManifest(docToTestUniqueResourceNames1),
+			makeManifest(docToTestUniqueResourceNames1),
+		},
+		Negative: true,
+	})
+
+	assert.True(t, pass)
+	assert.Equal(t, []string{}, diff)
+}
+
+func TestUniqueResourceNamesValidatorWhenNegativeAndFail(t *testing.T) {
+	validator := UniqueResourceNamesValidator{}
+	pass, diff := validator.Validate(&ValidateContext{
+		Docs: []common.K8sManifest{
+			makeManifest(docToTestUniqueResourceNames1),
+		},
+		Negative: true,
+	})
+
+	assert.False(t, pass)
+	assert.Equal(t, []string{
+		"Expected NOT resource names to be unique, no duplicates found",
+	}, diff)
+}
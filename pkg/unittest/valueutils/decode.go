@@ -0,0 +1,122 @@
+package valueutils
+
+import (
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/go-ini/ini"
+	"github.com/helm-unittest/helm-unittest/internal/common"
+	"github.com/vmware-labs/yaml-jsonpath/pkg/yamlpath"
+)
+
+// DecodeFormats the supported formats to decode values with
+var DecodeFormats = []string{"base64", "yaml", "json", "toml", "ini"}
+
+// Decode decode the content with the format, base64 content is decoded into a string,
+// the other formats are decoded into their structured value.
+func Decode(content, format string) (any, error) {
+	switch format {
+	case "base64":
+		decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(content))
+		if err != nil {
+			return nil, err
+		}
+		return string(decoded), nil
+	case "yaml", "json":
+		var decoded any
+		if err := common.YmlUnmarshal(content, &decoded); err != nil {
+			return nil, err
+		}
+		return decoded, nil
+	case "toml":
+		decoded := make(map[string]any)
+		if err := toml.Unmarshal([]byte(content), &decoded); err != nil {
+			return nil, err
+		}
+		return decoded, nil
+	case "ini":
+		return decodeIni(content)
+	default:
+		return nil, ValidateDecodeFormat(format)
+	}
+}
+
+// ValidateDecodeFormat returns an error when the format is not one of the DecodeFormats
+func ValidateDecodeFormat(format string) error {
+	if slices.Contains(DecodeFormats, format) {
+		return nil
+	}
+	return fmt.Errorf("unsupported decode format '%s', expected one of %s or %s",
+		format, strings.Join(DecodeFormats[:len(DecodeFormats)-1], ", "), DecodeFormats[len(DecodeFormats)-1])
+}
+
+// decodeIni decode ini content, the keys of the default section are placed at the top level
+// and the other sections are nested maps.
+func decodeIni(content string) (map[string]any, error) {
+	file, err := ini.Load([]byte(content))
+	if err != nil {
+		return nil, err
+	}
+
+	decoded := make(map[string]any)
+	for _, section := range file.Sections() {
+		values := decoded
+		if section.Name() != ini.DefaultSection {
+			values = make(map[string]any)
+			decoded[section.Name()] = values
+		}
+		for _, key := range section.Keys() {
+			values[key.Name()] = key.Value()
+		}
+	}
+	return decoded, nil
+}
+
+// DecodeValuesOfSetPath decode the values of the `--set` format path from a manifest with the formats, in order.
+// A manifest with the decoded values is returned, the manifest itself is left unchanged.
+func DecodeValuesOfSetPath(manifest common.K8sManifest, path string, formats []string) (common.K8sManifest, error) {
+	node, err := valueToYamlNode(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	yamlPath, err := yamlpath.NewPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	valueNodes, err := yamlPath.Find(&node.Node)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, valueNode := range valueNodes {
+		var decoded any
+		if err := valueNode.Decode(&decoded); err != nil {
+			return nil, err
+		}
+
+		for _, format := range formats {
+			content, ok := decoded.(string)
+			if !ok {
+				return nil, fmt.Errorf("expect '%s' to be a string to decode as %s, got:\n%s", path, format, common.TrustedMarshalYAML(decoded))
+			}
+			if decoded, err = Decode(content, format); err != nil {
+				return nil, fmt.Errorf("can not decode '%s' as %s: %s", path, format, err.Error())
+			}
+		}
+
+		if err := valueNode.Encode(decoded); err != nil {
+			return nil, err
+		}
+	}
+
+	decodedManifest := make(common.K8sManifest)
+	if err := node.Node.Decode(&decodedManifest); err != nil {
+		return nil, err
+	}
+	return decodedManifest, nil
+}
@@ -0,0 +1,76 @@
+package valueutils_test
+
+import (
+	"testing"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+	. "github.com/helm-unittest/helm-unittest/pkg/unittest/valueutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeWithFormats(t *testing.T) {
+	tests := []struct {
+		name, content, format string
+		expected              any
+	}{
+		{name: "base64", content: "c2VjcmV0\n", format: "base64", expected: "secret"},
+		{name: "yaml", content: "a:\n  b: 1\n", format: "yaml", expected: map[string]any{"a": map[string]any{"b": 1}}},
+		{name: "json", content: `{"a": ["b"]}`, format: "json", expected: map[string]any{"a": []any{"b"}}},
+		{name: "toml", content: "name = \"app\"\n[server]\nport = 8080\n", format: "toml", expected: map[string]any{"name": "app", "server": map[string]any{"port": int64(8080)}}},
+		{name: "ini", content: "name = app\n[server]\nport = 8080\n", format: "ini", expected: map[string]any{"name": "app", "server": map[string]any{"port": "8080"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := Decode(tt.content, tt.format)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestDecodeWithUnsupportedFormat(t *testing.T) {
+	_, err := Decode("a", "xml")
+	assert.EqualError(t, err, "unsupported decode format 'xml', expected one of base64, yaml, json, toml or ini")
+}
+
+func TestDecodeWithInvalidContent(t *testing.T) {
+	_, err := Decode("not base64!", "base64")
+	assert.Error(t, err)
+}
+
+func TestDecodeValuesOfSetPath(t *testing.T) {
+	manifest := common.K8sManifest{
+		"data": map[string]any{
+			"password":    "c2VjcmV0",
+			"config.json": "eyJzZXJ2ZXIiOiB7InBvcnQiOiA4MDgwfX0=",
+		},
+	}
+
+	decoded, err := DecodeValuesOfSetPath(manifest, "data[\"config.json\"]", []string{"base64", "json"})
+	assert.NoError(t, err)
+	assert.Equal(t, common.K8sManifest{
+		"data": map[string]any{
+			"password":    "c2VjcmV0",
+			"config.json": map[string]any{"server": map[string]any{"port": 8080}},
+		},
+	}, decoded)
+
+	// the manifest itself is left unchanged
+	assert.Equal(t, "eyJzZXJ2ZXIiOiB7InBvcnQiOiA4MDgwfX0=", manifest["data"].(map[string]any)["config.json"])
+}
+
+func TestDecodeValuesOfSetPathWithUnknownPath(t *testing.T) {
+	manifest := common.K8sManifest{"data": map[string]any{"password": "c2VjcmV0"}}
+
+	decoded, err := DecodeValuesOfSetPath(manifest, "data.unknown", []string{"base64"})
+	assert.NoError(t, err)
+	assert.Equal(t, manifest, decoded)
+}
+
+func TestDecodeValuesOfSetPathWithNonStringValue(t *testing.T) {
+	manifest := common.K8sManifest{"data": map[string]any{"port": 8080}}
+
+	_, err := DecodeValuesOfSetPath(manifest, "data.port", []string{"base64"})
+	assert.EqualError(t, err, "expect 'data.port' to be a string to decode as base64, got:\n8080\n")
+}
@@ -0,0 +1,63 @@
+package valueutils
+
+import (
+	"github.com/vmware-labs/yaml-jsonpath/pkg/yamlpath"
+	yamlv3 "go.yaml.in/yaml/v3"
+)
+
+// RemoveValuesOfSetPath remove the values of the `--set` format path from the value.
+// A copy of the value without the values at the path is returned, the value itself is left unchanged.
+func RemoveValuesOfSetPath(value any, path string) (any, error) {
+	node, err := valueToYamlNode(value)
+	if err != nil {
+		return nil, err
+	}
+
+	yamlPath, err := yamlpath.NewPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	valueNodes, err := yamlPath.Find(&node.Node)
+	if err != nil {
+		return nil, err
+	}
+
+	removed := make(map[*yamlv3.Node]bool, len(valueNodes))
+	for _, valueNode := range valueNodes {
+		removed[valueNode] = true
+	}
+	removeNodes(&node.Node, removed)
+
+	var result any
+	if err := node.Node.Decode(&result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// removeNodes remove the mapping entries and sequence items with a removed value from the node and its children.
+func removeNodes(node *yamlv3.Node, removed map[*yamlv3.Node]bool) {
+	switch node.Kind {
+	case yamlv3.MappingNode:
+		content := make([]*yamlv3.Node, 0, len(node.Content))
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if removed[node.Content[i+1]] {
+				continue
+			}
+			removeNodes(node.Content[i+1], removed)
+			content = append(content, node.Content[i], node.Content[i+1])
+		}
+		node.Content = content
+	case yamlv3.SequenceNode, yamlv3.DocumentNode:
+		content := make([]*yamlv3.Node, 0, len(node.Content))
+		for _, child := range node.Content {
+			if removed[child] && node.Kind == yamlv3.SequenceNode {
+				continue
+			}
+			removeNodes(child, removed)
+			content = append(content, child)
+		}
+		node.Content = content
+	}
+}
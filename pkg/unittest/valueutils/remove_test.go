@@ -0,0 +1,57 @@
+package valueutils_test
+
+import (
+	"testing"
+
+	. "github.com/helm-unittest/helm-unittest/pkg/unittest/valueutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoveValuesOfSetPath(t *testing.T) {
+	value := map[string]any{
+		"annotations": map[string]any{"checksum/config": "4f2a", "team": "web"},
+		"env":         []any{map[string]any{"name": "A", "value": "1"}, map[string]any{"name": "B", "value": "2"}},
+	}
+
+	tests := []struct {
+		path     string
+		expected any
+	}{
+		{
+			path: `annotations["checksum/config"]`,
+			expected: map[string]any{
+				"annotations": map[string]any{"team": "web"},
+				"env":         []any{map[string]any{"name": "A", "value": "1"}, map[string]any{"name": "B", "value": "2"}},
+			},
+		},
+		{
+			path: "env[*].value",
+			expected: map[string]any{
+				"annotations": map[string]any{"checksum/config": "4f2a", "team": "web"},
+				"env":         []any{map[string]any{"name": "A"}, map[string]any{"name": "B"}},
+			},
+		},
+		{
+			path: "env[0]",
+			expected: map[string]any{
+				"annotations": map[string]any{"checksum/config": "4f2a", "team": "web"},
+				"env":         []any{map[string]any{"name": "B", "value": "2"}},
+			},
+		},
+		{
+			path:     "unknown",
+			expected: value,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			actual, err := RemoveValuesOfSetPath(value, tt.path)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+
+	// the value itself is left unchanged
+	assert.Equal(t, "4f2a", value["annotations"].(map[string]any)["checksum/config"])
+}
@@ -17,22 +17,16 @@ func GetValueOfSetPath(manifest common.K8sManifest, path string) ([]any, error)
 	if path == "" {
 		return append(manifestResult, manifest), nil
 	}
-
-	byteBuffer := new(bytes.Buffer)
-
-	// Convert K8Manifest to yaml.Node
-	node := common.NewYamlNode()
-	yamlEncoder := common.YamlNewEncoder(byteBuffer)
-	yamlEncoder.SetIndent(common.YAMLINDENTION)
-
-	err := yamlEncoder.Encode(manifest)
-	if err != nil {
-		return nil, err
+	// The path `.` is the document itself, or the content of a raw document like a scalar element of a quantifier.
+	if path == "." {
+		if content, ok := manifest[common.RAW]; ok && len(manifest) == 1 {
+			return append(manifestResult, content), nil
+		}
+		return append(manifestResult, manifest), nil
 	}
 
-	yamlDecoder := common.YamlNewDecoder(byteBuffer)
-
-	if err := yamlDecoder.Decode(&node.Node); err != nil {
+	node, err := valueToYamlNode(manifest)
+	if err != nil {
 		return nil, err
 	}
 
@@ -59,6 +53,23 @@ func GetValueOfSetPath(manifest common.K8sManifest, path string) ([]any, error)
 	return manifestResult, nil
 }
 
+// valueToYamlNode convert the value, like a manifest, to a yaml.Node, to search it with a path
+func valueToYamlNode(value any) (common.YamlNode, error) {
+	byteBuffer := new(bytes.Buffer)
+
+	node := common.NewYamlNode()
+	yamlEncoder := common.YamlNewEncoder(byteBuffer)
+	yamlEncoder.SetIndent(common.YAMLINDENTION)
+
+	if err := yamlEncoder.Encode(value); err != nil {
+		return node, err
+	}
+
+	yamlDecoder := common.YamlNewDecoder(byteBuffer)
+	err := yamlDecoder.Decode(&node.Node)
+	return node, err
+}
+
 // BuildValueOfSetPath build the complete form the `--set` format path and its value
 func BuildValueOfSetPath(val any, path string) (map[string]any, error) {
 	if path == "" {